@@ -5,9 +5,12 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"log/slog"
 	"net"
 	"net/http"
 	"net/http/httptrace"
+	"net/netip"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -42,6 +45,35 @@ type clientConfig struct {
 	router           Processor
 	defaultParseMode string
 	numWorkers       int
+	uploadLimit      int64
+	uploadLimitSet   bool
+	fileIDStore      FileIDStore
+
+	webhookAllowedNetworks []netip.Prefix
+	webhookTrustedProxies  []netip.Prefix
+	webhookMaxConnsPerIP   int
+	webhookHandlers        map[string]http.Handler
+
+	updateStore UpdateStore
+
+	dropPendingUpdates bool
+	maxUpdateAge       time.Duration
+	handlerStale       HandlerFunc
+	handlerOverload    HandlerFunc
+	handlerLag         func(ctx *Context, lag time.Duration)
+
+	dispatcher Dispatcher
+
+	interceptors []Interceptor
+	metrics      *Metrics
+	tracer       Tracer
+	logger       *slog.Logger
+
+	backoffMin        time.Duration
+	backoffMax        time.Duration
+	giveUpAfter       time.Duration
+	conflictPolicy    ConflictPolicy
+	handlerConnection func(ctx context.Context, state ConnectionState, err error)
 }
 
 // WithHost sets the host for the Client.
@@ -96,14 +128,27 @@ func WithRouter(router Processor) ClientOption {
 	}
 }
 
-// WithNumWorkers sets the number of concurrent update-processing goroutines.
-// By default equals to the GetUpdates Limit value.
+// WithNumWorkers sets the number of concurrent update-processing goroutines
+// of the default dispatcher. By default equals to the GetUpdates Limit value
+// when polling, and to 100 for webhooks and other update sources.
 func WithNumWorkers(n int) ClientOption {
 	return func(c *Client) {
 		c.cfg.numWorkers = n
 	}
 }
 
+// WithUploadLimit sets the maximum size of an uploaded file; calls with larger
+// files fail with [ErrFileTooLarge] before they are sent. By default equals to
+// [UploadMaxSize], or to [LocalUploadMaxSize] when a local Bot API server is
+// set with [WithHost]. Photos sent with sendPhoto are further limited to
+// [PhotoMaxSize]. Zero or negative disables the check, for photos too.
+func WithUploadLimit(limit int64) ClientOption {
+	return func(c *Client) {
+		c.cfg.uploadLimit = limit
+		c.cfg.uploadLimitSet = true
+	}
+}
+
 var defaultOpts = []ClientOption{
 	WithHost(defaultHost),
 	WithRPS(defaultRPS),
@@ -126,6 +171,9 @@ type Client struct {
 	httpTrace             *httptrace.ClientTrace
 	localAddr, remoteAddr atomic.Value
 
+	invoker    Invoker
+	priorities priorityGate
+
 	runMu sync.Mutex
 	run   *runState
 }
@@ -210,6 +258,23 @@ func NewClient(token string, opts ...ClientOption) (*Client, error) {
 		opt(c)
 	}
 
+	if !c.cfg.uploadLimitSet {
+		c.cfg.uploadLimit = UploadMaxSize
+		if c.cfg.host != defaultHost {
+			c.cfg.uploadLimit = LocalUploadMaxSize
+		}
+	}
+
+	interceptors := slices.Clip(c.cfg.interceptors)
+	if c.cfg.tracer != nil {
+		interceptors = append(interceptors, c.traceCall)
+	}
+	if c.cfg.metrics != nil {
+		interceptors = append(interceptors, c.cfg.metrics.interceptor)
+	}
+
+	c.invoker = chainInterceptors(c.send, interceptors)
+
 	return c, nil
 }
 
@@ -236,8 +301,15 @@ func (c *Client) RemoteAddr() net.Addr {
 }
 
 // Do sends an HTTP request and returns an HTTP response.
+// The request waits for the rate limiter according to the options set
+// on its context with [ContextWithCallPriority] and [ContextWithoutRateLimit].
 func (c *Client) Do(req *http.Request) (*http.Response, error) {
-	if err := c.cfg.rateLimiter.Wait(req.Context()); err != nil {
+	if err := c.waitRateLimit(req.Context(), callOptionsFrom(req.Context())); err != nil {
+		// Close the body like http.Client.Do does on errors.
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+
 		return nil, err
 	}
 
@@ -251,6 +323,10 @@ type contextKey struct {
 var retryCountContextKey = &contextKey{name: "retry-count"}
 
 // Raw sends a raw request to the Telegram Bot API.
+// The call passes through the interceptors set with [WithInterceptors].
+// The timeout and retries of the call can be overridden on ctx with
+// [ContextWithCallTimeout] and [ContextWithCallRetryPolicy]. Errors are
+// returned as [*RequestError] with the bot token redacted.
 func (c *Client) Raw(
 	ctx context.Context,
 	method string,
@@ -258,10 +334,46 @@ func (c *Client) Raw(
 	contentType string,
 	dst []byte,
 ) (json.RawMessage, error) {
+	return c.invoker(ctx, &Call{
+		Method:      method,
+		Body:        reader,
+		ContentType: contentType,
+		dst:         dst,
+	})
+}
+
+// send is the innermost [Invoker] sending the call to the Bot API.
+func (c *Client) send(ctx context.Context, call *Call) (json.RawMessage, error) {
+	if scope, ok := ctx.Value(webhookReplyScopeContextKey).(*webhookReplyScope); ok && scope.claim(call.Method, call.Body, call.ContentType) {
+		// The call is delivered in the webhook response, its result is unknown.
+		call.replied = true
+		return nil, nil
+	}
+
+	result, status, err := c.raw(ctx, call)
+
+	if form, ok := call.Body.(*multipartForm); ok && form.fileIDs != nil {
+		form.fileIDs.finish(ctx, result, err)
+	}
+
+	if err != nil {
+		return nil, &RequestError{
+			Method:     call.Method,
+			StatusCode: status,
+			Err:        redactError(err, c.token),
+		}
+	}
+
+	return result, nil
+}
+
+// raw sends the request and returns the HTTP status code along with the result.
+func (c *Client) raw(ctx context.Context, call *Call) (json.RawMessage, int, error) {
 	innerCtx := httptrace.WithClientTrace(ctx, c.httpTrace)
 
-	timeout := c.cfg.timeout
-	link := c.cfg.linkPrefix + method
+	opts := callOptionsFrom(ctx)
+	timeout := c.callTimeout(opts)
+	link := c.cfg.linkPrefix + call.Method
 
 	if timeout > 0 {
 		var cancel context.CancelFunc
@@ -269,144 +381,174 @@ func (c *Client) Raw(
 		defer cancel()
 	}
 
-	req, err := http.NewRequestWithContext(innerCtx, http.MethodPost, link, reader)
+	if form, ok := call.Body.(*multipartForm); ok {
+		if err := form.checkSize(call.Method, c.cfg.uploadLimit); err != nil {
+			return nil, 0, err
+		}
+
+		form.bind(innerCtx, call.Method, opts)
+	}
+
+	body := call.Body
+
+	shared, isShared := call.Body.(sharedBody)
+	if isShared {
+		// The Transport closes each request body, which may be after the call returns.
+		body = newBodyAttempt(shared)
+	}
+
+	req, err := http.NewRequestWithContext(innerCtx, http.MethodPost, link, body)
 	if err != nil {
-		return nil, err
+		if isShared {
+			_ = body.(io.Closer).Close()
+		}
+
+		return nil, 0, err
+	}
+
+	if body, ok := call.Body.(interface{ ContentLength() int64 }); ok {
+		req.ContentLength = body.ContentLength()
 	}
 
-	req.Header.Set("Content-Type", contentType)
+	if isShared {
+		req.GetBody = func() (io.ReadCloser, error) {
+			if err := rewindBody(shared); err != nil {
+				return nil, err
+			}
+
+			return newBodyAttempt(shared), nil
+		}
+	} else if seeker, ok := call.Body.(io.ReadSeeker); ok && req.GetBody == nil {
+		// Let net/http resend the body, e.g. after a dropped keep-alive connection.
+		req.GetBody = func() (io.ReadCloser, error) {
+			if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+				return nil, err
+			}
+
+			return io.NopCloser(seeker), nil
+		}
+	}
+
+	for key, values := range call.Header {
+		req.Header[key] = values
+	}
+
+	req.Header.Set("Content-Type", call.ContentType)
 
 	resp, err := c.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	defer resp.Body.Close() //nolint:errcheck
 
-	buffer := acquireBuffer()
-	defer releaseBuffer(buffer)
+	buffer := call.response
+	if buffer == nil {
+		buffer = acquireBuffer()
+		defer releaseBuffer(buffer)
+	} else {
+		buffer.Reset()
+	}
 
 	_, err = io.Copy(buffer, resp.Body)
+	_ = resp.Body.Close()
+
 	if err != nil {
-		return nil, err
+		return nil, resp.StatusCode, err
 	}
 
-	var v Response
+	result, ok := okResult(buffer.B)
+	if !ok {
+		var v Response
 
-	err = json.Unmarshal(buffer.Bytes(), &v)
-	if err != nil {
-		return nil, err
+		err = json.Unmarshal(buffer.B, &v)
+		if err != nil {
+			return nil, resp.StatusCode, err
+		}
+
+		if !v.OK {
+			err = genError(v.ErrorCode, resp.Status, v.Description, v.Parameters)
+			return c.handleRetryErr(ctx, call, err, resp.StatusCode)
+		}
+
+		result = v.Result
 	}
 
-	if !v.OK {
-		err = genError(v.ErrorCode, resp.Status, v.Description, v.Parameters)
-		return c.handleRetryErr(ctx, method, reader, contentType, err, dst)
+	if buffer == call.response {
+		return result, resp.StatusCode, nil
 	}
 
-	return append(dst, v.Result...), nil
+	return append(call.dst, result...), resp.StatusCode, nil
 }
 
-func (c *Client) handleRetryErr(
-	ctx context.Context,
-	method string,
-	reader io.Reader,
-	contentType string,
-	err error,
-	dst []byte,
-) (json.RawMessage, error) {
-	const retryLimit = 5
+// okResult returns the result of a successful response without decoding it.
+// It reports false for failed or malformed responses, which are decoded in full.
+func okResult(data []byte) (json.RawMessage, bool) {
+	var (
+		ok     bool
+		result json.RawMessage
+	)
+
+	err := scanJSONObject(data, func(key, value []byte) error {
+		switch string(key) {
+		case "ok":
+			ok = string(value) == "true"
+
+		case "result":
+			result = value
+		}
+
+		return nil
+	})
 
+	return result, ok && err == nil
+}
+
+func (c *Client) handleRetryErr(ctx context.Context, call *Call, err error, status int) (json.RawMessage, int, error) {
 	if retryErr, ok := errors.AsType[*RetryError](err); ok {
+		policy := c.retryPolicy(callOptionsFrom(ctx))
+
 		retryCount := 0
 		if v := ctx.Value(retryCountContextKey); v != nil {
 			retryCount = v.(int)
 		}
 
-		if retryCount < retryLimit {
+		if retryCount < policy.MaxRetries && (policy.MaxDelay <= 0 || retryErr.RetryAfter <= policy.MaxDelay) {
 			retryCount++
 			ctx = context.WithValue(ctx, retryCountContextKey, retryCount)
 
+			c.cfg.metrics.observeRetry(call.Method, retryErr.RetryAfter)
+			c.log(ctx, slog.LevelInfo, "gogram: retrying rate limited call",
+				slog.String("method", call.Method),
+				slog.Duration("retry_after", retryErr.RetryAfter),
+				slog.Int("attempt", retryCount),
+			)
+
 			select {
 			case <-ctx.Done():
-				return nil, ctx.Err()
+				return nil, status, ctx.Err()
 			case <-time.After(retryErr.RetryAfter):
 			}
 
-			if reader != nil {
-				if seeker, ok := reader.(io.Seeker); ok {
+			if shared, ok := call.Body.(sharedBody); ok {
+				// Supersedes the previous request, which may still be reading the body.
+				if err = rewindBody(shared); err != nil {
+					return nil, status, err
+				}
+			} else if call.Body != nil {
+				if seeker, ok := call.Body.(io.Seeker); ok {
 					if _, err = seeker.Seek(0, io.SeekStart); err != nil {
-						return nil, err
+						return nil, status, err
 					}
 				} else {
 					// Cannot rewind reader, retry would send empty body.
-					return nil, err
+					return nil, status, err
 				}
 			}
 
-			return c.Raw(ctx, method, reader, contentType, dst)
+			return c.raw(ctx, call)
 		}
 	}
 
-	return nil, err
-}
-
-func (c *Client) startPolling(ctx context.Context, params *GetUpdatesParams, workers []chan *Context) {
-	numWorkers := int64(len(workers))
-	router := c.cfg.router
-
-	for {
-		select {
-		case <-ctx.Done():
-			return
-
-		default:
-		}
-
-		batch, err := c.GetUpdates(ctx, params)
-		if err != nil {
-			gogramCtx := c.acquireContext(ctx, nil)
-			router.HandleErr(gogramCtx, err)
-			c.releaseContext(gogramCtx)
-
-			if errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrNotFoundBanned) {
-				return
-			}
-
-			select {
-			case <-ctx.Done():
-				return
-
-			case <-time.After(defaultTimeoutOnError):
-			}
-
-			continue
-		}
-
-		for i := range batch {
-			params.Offset = batch[i].UpdateID + 1
-			gogramCtx := c.acquireContext(ctx, &batch[i])
-
-			// send update to same worker
-			var chatID int64
-
-			if chat := gogramCtx.Chat(); chat != nil {
-				chatID = chat.ID
-			} else {
-				chatID = batch[i].UpdateID
-			}
-
-			idx := chatID % numWorkers
-			if idx < 0 {
-				idx = -idx
-			}
-
-			select {
-			case workers[idx] <- gogramCtx:
-			case <-ctx.Done():
-				c.releaseContext(gogramCtx)
-				return
-			}
-		}
-	}
+	return nil, status, err
 }
 
 func (c *Client) beginRun(ctx context.Context) (context.Context, *runState, error) {
@@ -0,0 +1,52 @@
+package gogram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+)
+
+// ErrUpdateTypeNotAllowed indicates that handlers are registered for update
+// types excluded from allowed_updates, so they are never called.
+// It is logged at Warn and reported to the router's HandleErr on start.
+var ErrUpdateTypeNotAllowed = errors.New("gogram: handled update types are not allowed")
+
+// allowedUpdatesSource is implemented by processors that know which update
+// types they handle, such as [Router].
+type allowedUpdatesSource interface {
+	AllowedUpdates() []string
+}
+
+// allowedUpdates returns the allowed_updates list to use for a run.
+// An explicit list is kept as is and checked against the router; an empty one
+// is derived from the router.
+func (c *Client) allowedUpdates(ctx context.Context, explicit []string) []string {
+	source, ok := c.cfg.router.(allowedUpdatesSource)
+	if !ok {
+		return explicit
+	}
+
+	handled := source.AllowedUpdates()
+	if len(explicit) == 0 {
+		return handled
+	}
+
+	var excluded []string
+
+	for _, name := range handled {
+		if !slices.Contains(explicit, name) {
+			excluded = append(excluded, name)
+		}
+	}
+
+	if len(excluded) != 0 {
+		err := fmt.Errorf("%w: %s", ErrUpdateTypeNotAllowed, strings.Join(excluded, ", "))
+		c.log(ctx, slog.LevelWarn, "gogram: handlers will not be called", slog.Any("error", err))
+		c.handleErr(ctx, err)
+	}
+
+	return explicit
+}
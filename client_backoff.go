@@ -0,0 +1,169 @@
+package gogram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	defaultBackoffMin = time.Second
+	defaultBackoffMax = 30 * time.Second
+)
+
+// ErrPollingGaveUp indicates that polling failed for longer than the period
+// set with [WithPollingGiveUpAfter]. It wraps the last error.
+var ErrPollingGaveUp = errors.New("gogram: polling gave up")
+
+// ConflictPolicy decides how polling reacts to 409 Conflict errors:
+// another instance polling the same bot or an active webhook.
+type ConflictPolicy int
+
+// Conflict policies.
+const (
+	// ConflictRetry waits with backoff and retries.
+	ConflictRetry ConflictPolicy = iota
+	// ConflictStop stops polling and returns the conflict error.
+	ConflictStop
+	// ConflictTakeOver deletes an active webhook and retries at once. A
+	// conflict with another instance is retried with backoff, each
+	// getUpdates request terminating the one of the other instance.
+	ConflictTakeOver
+)
+
+// ConnectionState is reported to the handler set with [WithConnectionStateHandler].
+type ConnectionState int
+
+// Connection states.
+const (
+	// ConnectionLost is reported on the first failed request after a successful one.
+	ConnectionLost ConnectionState = iota + 1
+	// ConnectionRestored is reported on the first successful request after a failure.
+	ConnectionRestored
+)
+
+// WithPollingBackoff sets the delays between failed getUpdates requests. The
+// delay starts at minDelay, doubles after every failure up to maxDelay and
+// is randomized by up to a half. Defaults are 1s and 30s.
+func WithPollingBackoff(minDelay, maxDelay time.Duration) ClientOption {
+	return func(c *Client) {
+		c.cfg.backoffMin = minDelay
+		c.cfg.backoffMax = max(minDelay, maxDelay)
+	}
+}
+
+// WithPollingGiveUpAfter stops polling with [ErrPollingGaveUp] once requests
+// keep failing for d. Zero, the default, retries forever.
+func WithPollingGiveUpAfter(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.cfg.giveUpAfter = d
+	}
+}
+
+// WithConflictPolicy sets how polling handles [ErrConflict] errors.
+func WithConflictPolicy(policy ConflictPolicy) ClientOption {
+	return func(c *Client) {
+		c.cfg.conflictPolicy = policy
+	}
+}
+
+// WithConnectionStateHandler sets a handler notified when polling loses and
+// restores its connection to the Bot API. err is the failure for [ConnectionLost].
+func WithConnectionStateHandler(handler func(ctx context.Context, state ConnectionState, err error)) ClientOption {
+	return func(c *Client) {
+		c.cfg.handlerConnection = handler
+	}
+}
+
+// backoff computes polling retry delays and tracks the connection state.
+type backoff struct {
+	client *Client
+
+	attempt      int
+	failingSince time.Time
+}
+
+// fail records err and returns the delay before the next attempt,
+// or a non-nil error when polling must stop.
+func (b *backoff) fail(ctx context.Context, err error) (time.Duration, error) {
+	c := b.client
+
+	if b.failingSince.IsZero() {
+		b.failingSince = time.Now()
+
+		if c.cfg.handlerConnection != nil {
+			c.cfg.handlerConnection(ctx, ConnectionLost, err)
+		}
+	}
+
+	if c.cfg.giveUpAfter > 0 && time.Since(b.failingSince) >= c.cfg.giveUpAfter {
+		return 0, fmt.Errorf("%w: %w", ErrPollingGaveUp, err)
+	}
+
+	if errors.Is(err, ErrConflict) {
+		switch c.cfg.conflictPolicy {
+		case ConflictStop:
+			return 0, err
+
+		case ConflictTakeOver:
+			if !errors.Is(err, ErrConflictWebhookActive) {
+				break
+			}
+
+			// Retry at once only the first time, in case the webhook keeps
+			// being set again.
+			_, deleteErr := c.DeleteWebhook(ctx, &DeleteWebhookParams{})
+			if deleteErr != nil {
+				c.log(ctx, slog.LevelWarn, "gogram: deleting webhook failed", slog.Any("error", deleteErr))
+			} else if b.attempt == 0 {
+				b.attempt++
+				return 0, nil
+			}
+
+		default:
+		}
+	}
+
+	minDelay, maxDelay := c.cfg.backoffMin, c.cfg.backoffMax
+	if minDelay <= 0 {
+		minDelay, maxDelay = defaultBackoffMin, defaultBackoffMax
+	}
+
+	delay := maxDelay
+	if b.attempt < 32 && minDelay<<b.attempt < maxDelay {
+		delay = minDelay << b.attempt
+	}
+
+	b.attempt++
+
+	delay = delay/2 + rand.N(delay/2+1) //nolint:gosec // G404: jitter does not need a secure source
+
+	if retryErr, ok := errors.AsType[*RetryError](err); ok {
+		delay = max(delay, retryErr.RetryAfter)
+	}
+
+	return delay, nil
+}
+
+// succeed resets the backoff after a successful request.
+func (b *backoff) succeed(ctx context.Context) {
+	if b.failingSince.IsZero() {
+		return
+	}
+
+	c := b.client
+	c.log(ctx, slog.LevelInfo, "gogram: connection restored",
+		slog.Int("failed_attempts", b.attempt),
+		slog.Duration("downtime", time.Since(b.failingSince)),
+	)
+
+	b.attempt = 0
+	b.failingSince = time.Time{}
+
+	if c.cfg.handlerConnection != nil {
+		c.cfg.handlerConnection(ctx, ConnectionRestored, nil)
+	}
+}
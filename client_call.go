@@ -0,0 +1,191 @@
+package gogram
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const defaultRetryLimit = 5
+
+// CallPriority orders API calls waiting for the client rate limiter.
+type CallPriority int
+
+// Call priorities.
+const (
+	// PriorityLow calls wait while calls of higher priorities are waiting.
+	PriorityLow CallPriority = iota - 1
+	// PriorityNormal is the default priority.
+	PriorityNormal
+	// PriorityHigh calls pass the rate limiter before waiting calls of lower priorities.
+	PriorityHigh
+
+	priorityCount = iota
+)
+
+// RetryPolicy controls retries of calls failed with a [RetryError].
+type RetryPolicy struct {
+	// MaxRetries is the number of retries; zero disables them.
+	MaxRetries int
+	// MaxDelay is the longest retry_after worth waiting for; zero means no limit.
+	MaxDelay time.Duration
+}
+
+var callOptionsContextKey = &contextKey{name: "call-options"}
+
+// callOptions are per-call overrides carried in a context.Context.
+type callOptions struct {
+	timeout    time.Duration
+	hasTimeout bool
+
+	retry    RetryPolicy
+	hasRetry bool
+
+	priority      CallPriority
+	skipRateLimit bool
+
+	progress         func(UploadProgress)
+	progressInterval time.Duration
+}
+
+func callOptionsFrom(ctx context.Context) callOptions {
+	opts, _ := ctx.Value(callOptionsContextKey).(*callOptions)
+	if opts == nil {
+		return callOptions{}
+	}
+
+	return *opts
+}
+
+func withCallOptions(ctx context.Context, update func(opts *callOptions)) context.Context {
+	opts := callOptionsFrom(ctx)
+	update(&opts)
+
+	return context.WithValue(ctx, callOptionsContextKey, &opts)
+}
+
+// ContextWithCallTimeout returns a copy of ctx whose API calls time out after
+// timeout instead of the client timeout set with [WithTimeout]. A zero or
+// negative timeout leaves calls limited by the ctx deadline only.
+func ContextWithCallTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return withCallOptions(ctx, func(opts *callOptions) {
+		opts.timeout = timeout
+		opts.hasTimeout = true
+	})
+}
+
+// ContextWithCallPriority returns a copy of ctx whose API calls wait for the
+// rate limiter with priority. Calls that already reserved a slot are not
+// preempted.
+func ContextWithCallPriority(ctx context.Context, priority CallPriority) context.Context {
+	return withCallOptions(ctx, func(opts *callOptions) {
+		opts.priority = min(max(priority, PriorityLow), PriorityHigh)
+	})
+}
+
+// ContextWithCallRetryPolicy returns a copy of ctx whose API calls are retried
+// according to policy. By default a call is retried up to 5 times.
+func ContextWithCallRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return withCallOptions(ctx, func(opts *callOptions) {
+		opts.retry = policy
+		opts.hasRetry = true
+	})
+}
+
+// ContextWithoutRateLimit returns a copy of ctx whose API calls bypass the
+// client rate limiter set with [WithRPS].
+func ContextWithoutRateLimit(ctx context.Context) context.Context {
+	return withCallOptions(ctx, func(opts *callOptions) {
+		opts.skipRateLimit = true
+	})
+}
+
+// callTimeout returns the HTTP timeout of a call, zero meaning none.
+func (c *Client) callTimeout(opts callOptions) time.Duration {
+	if opts.hasTimeout {
+		return max(opts.timeout, 0)
+	}
+
+	return c.cfg.timeout
+}
+
+// retryPolicy returns the retry policy of a call.
+func (*Client) retryPolicy(opts callOptions) RetryPolicy {
+	if opts.hasRetry {
+		return opts.retry
+	}
+
+	return RetryPolicy{MaxRetries: defaultRetryLimit}
+}
+
+// waitRateLimit blocks until the rate limiter allows a call of opts.
+func (c *Client) waitRateLimit(ctx context.Context, opts callOptions) error {
+	if opts.skipRateLimit {
+		return nil
+	}
+
+	if err := c.priorities.enter(ctx, opts.priority); err != nil {
+		return err
+	}
+	defer c.priorities.leave(opts.priority)
+
+	return c.cfg.rateLimiter.Wait(ctx)
+}
+
+// priorityGate holds back calls while calls of higher priorities are waiting
+// for the rate limiter. The zero value is ready to use.
+type priorityGate struct {
+	mu      sync.Mutex
+	waiting [priorityCount]int
+	changed chan struct{}
+}
+
+func (g *priorityGate) enter(ctx context.Context, priority CallPriority) error {
+	index := int(priority - PriorityLow)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for g.busyAbove(index) {
+		if g.changed == nil {
+			g.changed = make(chan struct{})
+		}
+		changed := g.changed
+
+		g.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			g.mu.Lock()
+			return ctx.Err()
+
+		case <-changed:
+		}
+		g.mu.Lock()
+	}
+
+	g.waiting[index]++
+
+	return nil
+}
+
+func (g *priorityGate) leave(priority CallPriority) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.waiting[priority-PriorityLow]--
+
+	if g.changed != nil {
+		close(g.changed)
+		g.changed = nil
+	}
+}
+
+func (g *priorityGate) busyAbove(index int) bool {
+	for _, n := range g.waiting[index+1:] {
+		if n != 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,98 @@
+package gogram
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/valyala/bytebufferpool"
+)
+
+// Call is a Bot API method call passed through the interceptor chain.
+type Call struct {
+	// Method is the Bot API method name.
+	Method string
+	// Params holds the method parameters, e.g. *SendMessageParams, or nil
+	// for calls made with [Client.Raw]. They are already encoded into Body,
+	// so changing them does not change the request.
+	Params any
+	// Body and ContentType are the encoded request; replace both to rewrite it.
+	// Body may be backed by a pooled buffer, so it must not be used after the
+	// call returns.
+	Body        io.Reader
+	ContentType string
+	// Header holds additional HTTP request headers.
+	Header http.Header
+
+	dst      []byte
+	response *bytebufferpool.ByteBuffer
+	replied  bool // delivered in the webhook response by send
+}
+
+// Invoker performs a call and returns its raw JSON result. The result of a
+// generated method call points into a pooled buffer reused once the method
+// returns, so an interceptor keeping it must copy it.
+type Invoker func(ctx context.Context, call *Call) (json.RawMessage, error)
+
+// Interceptor wraps an Invoker to observe, alter or replace API calls,
+// the way [MiddlewareFunc] wraps handlers:
+//
+//	func logCalls(next gogram.Invoker) gogram.Invoker {
+//		return func(ctx context.Context, call *gogram.Call) (json.RawMessage, error) {
+//			start := time.Now()
+//			result, err := next(ctx, call)
+//			log.Println(call.Method, time.Since(start), err)
+//			return result, err
+//		}
+//	}
+//
+// An interceptor may return without calling next, e.g. to serve a cached or
+// mocked result. Retries of rate limited calls happen inside next.
+type Interceptor func(next Invoker) Invoker
+
+// WithInterceptors appends interceptors wrapping every API call, including
+// calls made with [Client.Raw]. They are applied in registration order, so
+// the first one is the outermost.
+func WithInterceptors(interceptors ...Interceptor) ClientOption {
+	return func(c *Client) {
+		c.cfg.interceptors = append(c.cfg.interceptors, interceptors...)
+	}
+}
+
+// chainInterceptors wraps invoker with interceptors, the first being the outermost.
+func chainInterceptors(invoker Invoker, interceptors []Interceptor) Invoker {
+	for _, interceptor := range slices.Backward(interceptors) {
+		invoker = interceptor(invoker)
+	}
+
+	return invoker
+}
+
+// call sends an API request through the interceptor chain. The HTTP response
+// is read into response, which the returned result points into. A call
+// delivered in the webhook response returns errWebhookReplied.
+func (c *Client) call(
+	ctx context.Context,
+	method string,
+	params any,
+	reader io.Reader,
+	contentType string,
+	response *bytebufferpool.ByteBuffer,
+) (json.RawMessage, error) {
+	call := &Call{
+		Method:      method,
+		Params:      params,
+		Body:        reader,
+		ContentType: contentType,
+		response:    response,
+	}
+
+	result, err := c.invoker(ctx, call)
+	if err == nil && call.replied {
+		return nil, errWebhookReplied
+	}
+
+	return result, err
+}
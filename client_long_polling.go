@@ -2,63 +2,62 @@ package gogram
 
 import (
 	"context"
-	"errors"
-	"sync"
 )
 
 // Start starts the client and listens for updates using long polling.
+// It is a shorthand for [Client.Run] with [Client.PollingSource].
 func (c *Client) Start(ctx context.Context, params *GetUpdatesParams) error {
-	innerCtx, state, err := c.beginRun(ctx)
-	if err != nil {
-		return err
-	}
-	defer c.finishRun(state)
+	return c.Run(ctx, c.PollingSource(params))
+}
 
-	var localParams GetUpdatesParams
+var _ UpdateSource = (*PollingSource)(nil)
 
-	if params != nil {
-		localParams = *params
-	}
-
-	if localParams.Limit == 0 {
-		localParams.Limit = defaultUpdates
-	}
+// PollingSource is an [UpdateSource] receiving updates with getUpdates long polling.
+//
+// With an [UpdateStore] configured and a zero Offset, polling resumes from
+// the last stored offset. An empty AllowedUpdates is derived from the
+// router's registered handlers.
+type PollingSource struct {
+	client *Client
+	params GetUpdatesParams
+}
 
-	numWorkers := localParams.Limit
-	if c.cfg.numWorkers > 0 {
-		numWorkers = int64(c.cfg.numWorkers)
-	}
+// PollingSource creates a PollingSource with a copy of params, which may be nil.
+func (c *Client) PollingSource(params *GetUpdatesParams) *PollingSource {
+	s := &PollingSource{client: c}
 
-	workerChans := make([]chan *Context, numWorkers)
-	for i := range numWorkers {
-		workerChans[i] = make(chan *Context, max(localParams.Limit/numWorkers, 1))
+	if params != nil {
+		s.params = *params
 	}
 
-	var wg sync.WaitGroup
-
-	wg.Go(func() {
-		defer func() {
-			for _, ch := range workerChans {
-				close(ch)
-			}
-		}()
-		c.startPolling(innerCtx, &localParams, workerChans)
-	})
+	return s
+}
 
-	for i := range numWorkers {
-		ch := workerChans[i]
-		wg.Go(func() {
-			for ctx := range ch {
-				c.processUpdate(ctx)
-			}
-		})
+// Receive implements [UpdateSource].
+// Updates are confirmed to Telegram regardless of the ack result.
+func (s *PollingSource) Receive(ctx context.Context, deliver DeliverFunc) error {
+	c := s.client
+	params := c.pollingParams(&s.params)
+
+	if c.cfg.dropPendingUpdates {
+		_, err := c.DeleteWebhook(ctx, &DeleteWebhookParams{DropPendingUpdates: true})
+		if err != nil {
+			return err
+		}
 	}
 
-	wg.Wait()
-
-	if err = ctx.Err(); !errors.Is(err, context.Canceled) {
+	if err := c.loadOffset(ctx, &params); err != nil {
 		return err
 	}
 
-	return nil
+	params.AllowedUpdates = c.allowedUpdates(ctx, params.AllowedUpdates)
+
+	return c.poll(ctx, &params, func(update *Update, err error) bool {
+		if err != nil {
+			c.handleErr(ctx, err)
+			return true
+		}
+
+		return deliver(ctx, update, nil) == nil
+	})
 }
@@ -0,0 +1,83 @@
+package gogram
+
+import "time"
+
+// WithDropPendingUpdates drops updates that arrived while the bot was offline.
+// [Client.Start] calls deleteWebhook with drop_pending_updates before polling;
+// [Client.StartWebhook] calls SetWebhook again with drop_pending_updates set,
+// see [WebhookSource].
+func WithDropPendingUpdates() ClientOption {
+	return func(c *Client) {
+		c.cfg.dropPendingUpdates = true
+	}
+}
+
+// WithMaxUpdateAge marks dated updates, such as messages, older than maxAge
+// as stale, based on [Update.Time]; callback queries and inline queries carry
+// no date and are never stale, even for a button of an old message, so use
+// [WithDropPendingUpdates] to skip those sent while the bot was offline.
+// Stale updates skip the router and go to the handler set by
+// [WithStaleUpdateHandler], or are dropped when there is none.
+func WithMaxUpdateAge(maxAge time.Duration) ClientOption {
+	return func(c *Client) {
+		c.cfg.maxUpdateAge = maxAge
+	}
+}
+
+// WithStaleUpdateHandler sets the handler for stale updates, e.g. to send a
+// single "sorry, I was offline" message per chat. Its errors and panics are
+// passed to the router's HandleErr and HandlePanic.
+func WithStaleUpdateHandler(handler HandlerFunc) ClientOption {
+	return func(c *Client) {
+		c.cfg.handlerStale = handler
+	}
+}
+
+func (c *Client) isStale(update *Update) bool {
+	if c.cfg.maxUpdateAge <= 0 || update == nil {
+		return false
+	}
+
+	date := update.Time()
+
+	return !date.IsZero() && time.Since(date) > c.cfg.maxUpdateAge
+}
+
+// WithOverloadHandler sets the handler for updates shed by the dispatcher
+// under load (see [WithOverflowPolicy]), e.g. to reply "bot is busy".
+// Its errors and panics are passed to the router's HandleErr and HandlePanic.
+// Without it shed updates are dropped.
+func WithOverloadHandler(handler HandlerFunc) ClientOption {
+	return func(c *Client) {
+		c.cfg.handlerOverload = handler
+	}
+}
+
+// WithUpdateLagHandler sets a hook called before each update is processed
+// with the delay between [Update.Time] and now. Updates without a date are skipped.
+func WithUpdateLagHandler(fn func(ctx *Context, lag time.Duration)) ClientOption {
+	return func(c *Client) {
+		c.cfg.handlerLag = fn
+	}
+}
+
+// processAside runs handler outside the router with the router's error and panic handling.
+func (c *Client) processAside(gogramCtx *Context, handler HandlerFunc) {
+	if handler == nil {
+		return
+	}
+
+	router := c.cfg.router
+
+	defer func() {
+		if v := recover(); v != nil {
+			gogramCtx.handlerErr = &PanicError{Value: v}
+			router.HandlePanic(gogramCtx, v)
+		}
+	}()
+
+	if err := handler(gogramCtx); err != nil {
+		gogramCtx.handlerErr = err
+		router.HandleErr(gogramCtx, err)
+	}
+}
@@ -1,5 +1,7 @@
 package gogram
 
+import "time"
+
 // Processor is an interface for processing updates.
 type Processor interface {
 	Process(ctx *Context)
@@ -7,7 +9,39 @@ type Processor interface {
 	HandlePanic(ctx *Context, v any)
 }
 
-func (c *Client) processUpdate(gogramCtx *Context) {
+// processUpdate processes and releases gogramCtx, returning the handler error.
+func (c *Client) processUpdate(gogramCtx *Context) error {
 	defer c.releaseContext(gogramCtx)
+
+	var lag time.Duration
+	if gogramCtx.update != nil {
+		if date := gogramCtx.update.Time(); !date.IsZero() {
+			lag = time.Since(date)
+		}
+	}
+
+	if m := c.cfg.metrics; m != nil {
+		start := time.Now()
+		defer func() {
+			m.observeUpdate(gogramCtx.findHandlerOn(), lag, time.Since(start), gogramCtx.handlerErr)
+		}()
+	}
+
+	if gogramCtx.overloaded {
+		c.processAside(gogramCtx, c.cfg.handlerOverload)
+		return gogramCtx.handlerErr
+	}
+
+	if c.cfg.handlerLag != nil && lag != 0 {
+		c.cfg.handlerLag(gogramCtx, lag)
+	}
+
+	if c.isStale(gogramCtx.update) {
+		c.processAside(gogramCtx, c.cfg.handlerStale)
+		return gogramCtx.handlerErr
+	}
+
 	c.cfg.router.Process(gogramCtx)
+
+	return gogramCtx.handlerErr
 }
@@ -5,12 +5,17 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/netip"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -121,6 +126,80 @@ func TestClient_StartPolling_Success(t *testing.T) {
 	}
 }
 
+// TestClient_StartPolling_WorkersFollowLimit validates that the default
+// dispatcher runs as many workers as the GetUpdates Limit.
+func TestClient_StartPolling_WorkersFollowLimit(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		var updates []gogram.Update
+		if requests.Add(1) == 1 {
+			for i := range int64(4) {
+				updates = append(updates, gogram.Update{UpdateID: i + 1, Message: &gogram.Message{Chat: gogram.Chat{ID: i}}})
+			}
+		} else {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		_, _ = w.Write(mustMarshal(t, &gogram.Response{OK: true, Result: mustMarshal(t, updates)}))
+	}))
+	defer server.Close()
+
+	var (
+		mu           sync.Mutex
+		active, peak int
+		processed    atomic.Int32
+	)
+	router := gogram.NewRouter()
+	router.HandleOnMessage(func(*gogram.Context, *gogram.Message) error {
+		mu.Lock()
+		active++
+		peak = max(peak, active)
+		mu.Unlock()
+
+		time.Sleep(50 * time.Millisecond)
+
+		mu.Lock()
+		active--
+		mu.Unlock()
+		processed.Add(1)
+
+		return nil
+	})
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost(strings.TrimPrefix(server.URL, "https://")),
+		gogram.WithHTTPClient(server.Client()),
+		gogram.WithRouter(router),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+	defer cancel()
+
+	go func() {
+		for processed.Load() < 4 {
+			time.Sleep(10 * time.Millisecond)
+		}
+		cancel()
+	}()
+
+	_ = client.Start(ctx, &gogram.GetUpdatesParams{Limit: 2})
+
+	if got := processed.Load(); got != 4 {
+		t.Fatalf("processed %d updates, want 4", got)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+
+	if peak != 2 {
+		t.Errorf("peak concurrency = %d, want 2", peak)
+	}
+}
+
 // TestClient_StartPolling_Resilience validates that the polling loop survives
 // transient server errors and retries successfully.
 //
@@ -235,7 +314,7 @@ func TestContextMethod_PropagatesHandlerContext(t *testing.T) {
 
 			resp := gogram.Response{
 				OK:     true,
-				Result: json.RawMessage(`{}`),
+				Result: json.RawMessage(`{"id":42,"is_bot":true}`),
 			}
 
 			return jsonHTTPResponse(t, &resp), nil
@@ -253,10 +332,13 @@ func TestContextMethod_PropagatesHandlerContext(t *testing.T) {
 	baseCtx := context.WithValue(t.Context(), traceKey{}, "trace-value")
 	gogramCtx := gogram.NewTestContext(baseCtx, client, nil)
 
-	err = gogramCtx.GetMe()
+	me, err := gogramCtx.GetMe()
 	if err != nil {
 		t.Fatalf("GetMe: %v", err)
 	}
+	if me.ID != 42 {
+		t.Errorf("GetMe returned user %d, want 42", me.ID)
+	}
 	if !sawValue.Load() {
 		t.Error("transport did not observe the trace value")
 	}
@@ -309,7 +391,7 @@ func TestContextMethod_WithoutCancel_PreservesValuesAfterParentCancel(t *testing
 
 	gogramCtx := gogram.NewTestContext(baseCtx, client, nil)
 
-	err = gogramCtx.GetMe()
+	_, err = gogramCtx.GetMe()
 	if err != nil {
 		t.Fatalf("GetMe: %v", err)
 	}
@@ -451,45 +533,1179 @@ func TestClient_Stop_DrainsWorkerQueues(t *testing.T) {
 	}
 }
 
-// mustMarshal is a test helper to simplify JSON marshaling checks.
-func mustMarshal(t *testing.T, v any) []byte {
-	t.Helper()
-	b, err := json.Marshal(v)
+func TestClient_Webhook_ReplyInResponse(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		apiTexts []string
+	)
+	httpClient := &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			var params gogram.SendMessageParams
+			if err := json.NewDecoder(req.Body).Decode(&params); err != nil {
+				t.Errorf("decode API request: %v", err)
+			}
+
+			mu.Lock()
+			apiTexts = append(apiTexts, params.Text)
+			mu.Unlock()
+
+			return jsonHTTPResponse(t, &gogram.Response{OK: true, Result: json.RawMessage(`{}`)}), nil
+		}),
+	}
+
+	handled := make(chan error, 1)
+
+	router := gogram.NewRouter()
+	router.HandleOnMessage(func(ctx *gogram.Context, msg *gogram.Message) error {
+		handled <- func() error {
+			reply := ctx.WebhookReply()
+
+			if msg.Text == "unreadable" {
+				// The body is rewound for the Bot API when reading it fails.
+				reader := &failingOnceReader{Reader: strings.NewReader(`{"chat_id":"42","text":"unreadable"}`)}
+				_, err := ctx.Client().Raw(reply, "sendMessage", reader, "application/json", nil)
+				return err
+			}
+
+			// Calls made with ctx itself are not claimed.
+			if _, err := ctx.SendMessage("before"); err != nil {
+				return err
+			}
+
+			sent, err := reply.SendMessage("pong")
+			if err != nil {
+				return err
+			}
+			if sent != nil {
+				return fmt.Errorf("claimed SendMessage returned %+v, want nil", sent)
+			}
+
+			// Only the first call made with reply is claimed.
+			_, err = reply.SendMessage("after")
+			return err
+		}()
+
+		return nil
+	})
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost("example.invalid"),
+		gogram.WithHTTPClient(httpClient),
+		gogram.WithRouter(router),
+	)
 	if err != nil {
-		t.Fatalf("mustMarshal: %v", err)
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	addr := startTestWebhook(t, client, &gogram.SetWebhookParams{
+		URL:            "https://example.invalid/hook",
+		AllowedUpdates: []string{"message"},
+	})
+
+	post := func(text string) []byte {
+		t.Helper()
+
+		update := gogram.Update{UpdateID: 1, Message: &gogram.Message{Text: text, Chat: gogram.Chat{ID: 42}}}
+		resp, err := http.Post("http://"+addr+"/hook", "application/json", bytes.NewReader(mustMarshal(t, &update)))
+		if err != nil {
+			t.Fatalf("Post: %v", err)
+		}
+		defer resp.Body.Close() //nolint:errcheck
+
+		if err = <-handled; err != nil {
+			t.Fatalf("handler: %v", err)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("read webhook response: %v", err)
+		}
+
+		return body
+	}
+
+	var body map[string]any
+	if err = json.Unmarshal(post("ping"), &body); err != nil {
+		t.Fatalf("decode webhook response: %v", err)
+	}
+	if body["method"] != "sendMessage" || body["text"] != "pong" || body["chat_id"] != "42" {
+		t.Errorf("unexpected webhook response: %v", body)
+	}
+
+	if raw := post("unreadable"); len(raw) != 0 {
+		t.Errorf("unexpected webhook response: %s", raw)
+	}
+
+	// Without a webhook request the call falls back to the Bot API.
+	update := gogram.Update{UpdateID: 2, Message: &gogram.Message{Text: "ping", Chat: gogram.Chat{ID: 42}}}
+	ctx := gogram.NewTestContext(t.Context(), client, &update)
+	if _, err = ctx.WebhookReply().SendMessage("fallback"); err != nil {
+		t.Fatalf("SendMessage: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if want := []string{"before", "after", "unreadable", "fallback"}; !slices.Equal(apiTexts, want) {
+		t.Errorf("API calls = %q, want %q", apiTexts, want)
 	}
-	return b
 }
 
-type roundTripperFunc func(req *http.Request) (*http.Response, error)
+func TestClient_Webhook_RegistersDerivedAllowedUpdates(t *testing.T) {
+	t.Parallel()
 
-func (fn roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
-	return fn(req)
+	for _, tt := range []struct {
+		name        string
+		configured  []string
+		certificate io.Reader
+		wantCalls   []string
+		wantErr     error
+	}{
+		{
+			name:        "unchanged",
+			configured:  []string{"message"},
+			certificate: strings.NewReader("certificate"),
+			wantCalls:   []string{"getWebhookInfo"},
+		},
+		{
+			name:        "changed",
+			configured:  []string{"callback_query"},
+			certificate: strings.NewReader("certificate"),
+			wantCalls:   []string{"getWebhookInfo", "setWebhook"},
+		},
+		{
+			name:        "certificate not rewindable",
+			configured:  []string{"callback_query"},
+			certificate: io.MultiReader(strings.NewReader("certificate")),
+			wantCalls:   []string{"getWebhookInfo"},
+			wantErr:     gogram.ErrWebhookCertificate,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu       sync.Mutex
+				calls    []string
+				reported []error
+			)
+			httpClient := &http.Client{
+				Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					method := req.URL.Path[strings.LastIndexByte(req.URL.Path, '/')+1:]
+
+					mu.Lock()
+					calls = append(calls, method)
+					mu.Unlock()
+
+					if method == "getWebhookInfo" {
+						info := gogram.WebhookInfo{URL: "https://example.invalid/hook", AllowedUpdates: tt.configured}
+						return jsonHTTPResponse(t, &gogram.Response{OK: true, Result: mustMarshal(t, &info)}), nil
+					}
+
+					if err := req.ParseMultipartForm(1 << 20); err != nil {
+						t.Errorf("parse setWebhook: %v", err)
+					}
+					if got := req.FormValue("allowed_updates"); got != `["message"]` {
+						t.Errorf("setWebhook allowed_updates = %s, want [\"message\"]", got)
+					}
+
+					// The certificate is uploaded again in full.
+					if file, _, err := req.FormFile("certificate"); err != nil {
+						t.Errorf("setWebhook certificate: %v", err)
+					} else if data, _ := io.ReadAll(file); string(data) != "certificate" {
+						t.Errorf("setWebhook certificate = %q, want %q", data, "certificate")
+					}
+
+					return jsonHTTPResponse(t, &gogram.Response{OK: true, Result: json.RawMessage(`true`)}), nil
+				}),
+			}
+
+			router := gogram.NewRouter()
+			router.HandleOnMessage(func(*gogram.Context, *gogram.Message) error { return nil })
+			router.SetHandlerErr(func(_ *gogram.Context, err error) {
+				mu.Lock()
+				reported = append(reported, err)
+				mu.Unlock()
+			})
+
+			client, err := gogram.NewClient(testToken,
+				gogram.WithHost("example.invalid"),
+				gogram.WithHTTPClient(httpClient),
+				gogram.WithRouter(router),
+			)
+			if err != nil {
+				t.Fatalf("NewClient: %v", err)
+			}
+
+			// The certificate was read by the initial SetWebhook call.
+			_, _ = io.Copy(io.Discard, tt.certificate)
+
+			startTestWebhook(t, client, &gogram.SetWebhookParams{
+				URL:         "https://example.invalid/hook",
+				Certificate: &gogram.InputFile{File: tt.certificate, FileName: "cert.pem"},
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if !slices.Equal(calls, tt.wantCalls) {
+				t.Errorf("API calls = %q, want %q", calls, tt.wantCalls)
+			}
+
+			if tt.wantErr == nil && len(reported) != 0 {
+				t.Errorf("reported %v, want nothing", reported)
+			}
+			if tt.wantErr != nil && (len(reported) != 1 || !errors.Is(reported[0], tt.wantErr)) {
+				t.Errorf("reported %v, want %v", reported, tt.wantErr)
+			}
+		})
+	}
 }
 
-type failingReadCloser struct {
-	payload []byte
-	sent    bool
+// failingOnceReader fails its first read after consuming a byte.
+type failingOnceReader struct {
+	*strings.Reader
+	failed bool
 }
 
-func (r *failingReadCloser) Read(p []byte) (int, error) {
-	if r.sent {
-		return 0, io.ErrUnexpectedEOF
+func (r *failingOnceReader) Read(p []byte) (int, error) {
+	if !r.failed {
+		r.failed = true
+		_, _ = r.Reader.ReadByte()
+
+		return 0, errors.New("read failed")
 	}
 
-	r.sent = true
-	return copy(p, r.payload), nil
+	return r.Reader.Read(p)
 }
 
-func (*failingReadCloser) Close() error { return nil }
+func TestTelegramNetworks_ReturnsCopy(t *testing.T) {
+	t.Parallel()
 
-func jsonHTTPResponse(t *testing.T, payload any) *http.Response {
-	t.Helper()
+	networks := gogram.TelegramNetworks()
+	networks[0] = netip.MustParsePrefix("0.0.0.0/0")
 
-	return &http.Response{
-		StatusCode: http.StatusOK,
-		Status:     http.StatusText(http.StatusOK),
-		Header:     make(http.Header),
-		Body:       io.NopCloser(bytes.NewReader(mustMarshal(t, payload))),
+	if got := gogram.TelegramNetworks(); got[0] == networks[0] {
+		t.Errorf("TelegramNetworks()[0] = %v after modifying a previous result", got[0])
+	}
+}
+
+func TestClient_Webhook_RejectsRequests(t *testing.T) {
+	t.Parallel()
+
+	var rejected []*gogram.WebhookError
+	var mu sync.Mutex
+
+	router := gogram.NewRouter()
+	router.HandleOnMessage(func(*gogram.Context, *gogram.Message) error { return nil })
+	router.SetHandlerErr(func(_ *gogram.Context, err error) {
+		if webhookErr, ok := errors.AsType[*gogram.WebhookError](err); ok {
+			mu.Lock()
+			rejected = append(rejected, webhookErr)
+			mu.Unlock()
+		}
+	})
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithRouter(router),
+		gogram.WithWebhookAllowedNetworks(gogram.TelegramNetworks()...),
+		gogram.WithWebhookTrustedProxies(netip.MustParsePrefix("127.0.0.0/8")),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	addr := startTestWebhook(t, client, &gogram.SetWebhookParams{
+		URL:            "https://example.invalid/hook",
+		AllowedUpdates: []string{"message"},
+	})
+
+	post := func(contentType, forwardedFor string) int {
+		req, reqErr := http.NewRequestWithContext(t.Context(), http.MethodPost, "http://"+addr+"/hook",
+			strings.NewReader(`{"update_id":1,"message":{"text":"hi"}}`))
+		if reqErr != nil {
+			t.Fatalf("NewRequest: %v", reqErr)
+		}
+		req.Header.Set("Content-Type", contentType)
+		if forwardedFor != "" {
+			req.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+
+		resp, reqErr := http.DefaultClient.Do(req)
+		if reqErr != nil {
+			t.Fatalf("Do: %v", reqErr)
+		}
+		_ = resp.Body.Close()
+
+		return resp.StatusCode
+	}
+
+	if status := post("application/json", ""); status != http.StatusForbidden {
+		t.Errorf("direct request: got status %d, want %d", status, http.StatusForbidden)
+	}
+	if status := post("application/json", "10.0.0.1, 149.154.167.99"); status != http.StatusOK {
+		t.Errorf("proxied Telegram request: got status %d, want %d", status, http.StatusOK)
+	}
+	if status := post("text/plain", "149.154.167.99"); status != http.StatusUnsupportedMediaType {
+		t.Errorf("text/plain request: got status %d, want %d", status, http.StatusUnsupportedMediaType)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(rejected) != 2 {
+		t.Fatalf("expected 2 reported rejections, got %d", len(rejected))
+	}
+	if !errors.Is(rejected[0], gogram.ErrWebhookForbiddenSource) || rejected[0].RemoteAddr.String() != "127.0.0.1" {
+		t.Errorf("unexpected first rejection: %v", rejected[0])
+	}
+	if !errors.Is(rejected[1], gogram.ErrWebhookContentType) || rejected[1].RemoteAddr.String() != "149.154.167.99" {
+		t.Errorf("unexpected second rejection: %v", rejected[1])
+	}
+}
+
+func TestClient_StartPolling_UpdateStore(t *testing.T) {
+	t.Parallel()
+
+	var firstOffset atomic.Int64
+	var requests atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var params gogram.GetUpdatesParams
+		_ = json.NewDecoder(r.Body).Decode(&params)
+
+		var updates []gogram.Update
+		switch requests.Add(1) {
+		case 1:
+			firstOffset.Store(params.Offset)
+			updates = []gogram.Update{{UpdateID: 10, Message: &gogram.Message{Text: "a"}}}
+		case 2:
+			// Redelivery of an already handled update next to a new one.
+			updates = []gogram.Update{
+				{UpdateID: 10, Message: &gogram.Message{Text: "a"}},
+				{UpdateID: 11, Message: &gogram.Message{Text: "b"}},
+			}
+		default:
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		resp := gogram.Response{OK: true, Result: json.RawMessage(mustMarshal(t, updates))}
+		_, _ = w.Write(mustMarshal(t, &resp))
+	}))
+	defer server.Close()
+
+	var processed atomic.Int32
+	router := gogram.NewRouter()
+	router.HandleOnMessage(func(*gogram.Context, *gogram.Message) error {
+		processed.Add(1)
+		return nil
+	})
+
+	store := gogram.NewMemoryUpdateStore(0)
+	if err := store.SaveOffset(t.Context(), 123456, 10); err != nil {
+		t.Fatalf("SaveOffset: %v", err)
+	}
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost(strings.TrimPrefix(server.URL, "https://")),
+		gogram.WithHTTPClient(server.Client()),
+		gogram.WithRouter(router),
+		gogram.WithUpdateStore(store),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 500*time.Millisecond)
+	defer cancel()
+
+	_ = client.Start(ctx, nil)
+
+	if got := firstOffset.Load(); got != 10 {
+		t.Errorf("first getUpdates offset = %d, want 10", got)
+	}
+	if got := processed.Load(); got != 2 {
+		t.Errorf("processed %d updates, want 2", got)
+	}
+	if offset, _ := store.LoadOffset(t.Context(), 123456); offset != 12 {
+		t.Errorf("stored offset = %d, want 12", offset)
+	}
+}
+
+func TestClient_Webhook_StaleUpdates(t *testing.T) {
+	t.Parallel()
+
+	var processed, stale atomic.Int32
+	router := gogram.NewRouter()
+	router.HandleOnMessage(func(*gogram.Context, *gogram.Message) error {
+		processed.Add(1)
+		return nil
+	})
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithRouter(router),
+		gogram.WithMaxUpdateAge(time.Minute),
+		gogram.WithStaleUpdateHandler(func(ctx *gogram.Context) error {
+			if ctx.Chat().ID != 42 {
+				t.Errorf("unexpected stale chat: %d", ctx.Chat().ID)
+			}
+			stale.Add(1)
+			return nil
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	addr := startTestWebhook(t, client, &gogram.SetWebhookParams{
+		URL:            "https://example.invalid/hook",
+		AllowedUpdates: []string{"message"},
+	})
+
+	for i, date := range []time.Time{time.Now().Add(-time.Hour), time.Now()} {
+		update := gogram.Update{
+			UpdateID: int64(i + 1),
+			Message:  &gogram.Message{Text: "/start", Date: date.Unix(), Chat: gogram.Chat{ID: 42}},
+		}
+
+		resp, postErr := http.Post("http://"+addr+"/hook", "application/json", bytes.NewReader(mustMarshal(t, &update)))
+		if postErr != nil {
+			t.Fatalf("Post: %v", postErr)
+		}
+		_ = resp.Body.Close()
+	}
+
+	if got := stale.Load(); got != 1 {
+		t.Errorf("stale handler called %d times, want 1", got)
+	}
+	if got := processed.Load(); got != 1 {
+		t.Errorf("router handled %d updates, want 1", got)
+	}
+}
+
+func TestClient_MaxUpdateAge_CallbackQueryNeverStale(t *testing.T) {
+	t.Parallel()
+
+	var handled []string
+	router := gogram.NewRouter()
+	router.HandleOnMessage(func(_ *gogram.Context, msg *gogram.Message) error {
+		handled = append(handled, msg.Text)
+		return nil
+	})
+	router.HandleOnCallbackQuery(func(_ *gogram.Context, query *gogram.CallbackQuery) error {
+		handled = append(handled, query.Data)
+		return nil
+	})
+
+	var stale []int64
+	client, err := gogram.NewClient(testToken,
+		gogram.WithRouter(router),
+		gogram.WithNumWorkers(1),
+		gogram.WithMaxUpdateAge(time.Minute),
+		gogram.WithStaleUpdateHandler(func(ctx *gogram.Context) error {
+			stale = append(stale, ctx.Update().UpdateID)
+			return nil
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	old := time.Now().Add(-time.Hour).Unix()
+
+	deliveries := make(chan gogram.Delivery, 3)
+	deliveries <- gogram.Delivery{Update: &gogram.Update{UpdateID: 1, Message: &gogram.Message{Text: "old", Date: old}}}
+	deliveries <- gogram.Delivery{Update: &gogram.Update{UpdateID: 2, CallbackQuery: &gogram.CallbackQuery{
+		Data:    "button",
+		Message: &gogram.MaybeInaccessibleMessage{Message: &gogram.Message{Text: "menu", Date: old}},
+	}}}
+	deliveries <- gogram.Delivery{Update: &gogram.Update{UpdateID: 3, Message: &gogram.Message{Text: "new", Date: time.Now().Unix()}}}
+	close(deliveries)
+
+	if err = client.Run(t.Context(), gogram.ChannelSource(deliveries)); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if want := []int64{1}; !slices.Equal(stale, want) {
+		t.Errorf("stale updates = %v, want %v", stale, want)
+	}
+
+	if want := []string{"button", "new"}; !slices.Equal(handled, want) {
+		t.Errorf("handled = %v, want %v", handled, want)
+	}
+}
+
+func TestClient_Run_ChannelSourceAcks(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+
+	router := gogram.NewRouter()
+	router.HandleOnMessage(func(_ *gogram.Context, msg *gogram.Message) error {
+		if msg.Text == "fail" {
+			return errFailed
+		}
+		return nil
+	})
+
+	client, err := gogram.NewClient(testToken, gogram.WithRouter(router))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	acks := make(map[string]error)
+	var mu sync.Mutex
+
+	deliveries := make(chan gogram.Delivery, 2)
+	for _, text := range []string{"ok", "fail"} {
+		deliveries <- gogram.Delivery{
+			Update: &gogram.Update{Message: &gogram.Message{Text: text}},
+			Ack: func(err error) {
+				mu.Lock()
+				acks[text] = err
+				mu.Unlock()
+			},
+		}
+	}
+	close(deliveries)
+
+	if err = client.Run(t.Context(), gogram.ChannelSource(deliveries)); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if len(acks) != 2 {
+		t.Fatalf("expected 2 acks, got %d", len(acks))
+	}
+	if acks["ok"] != nil {
+		t.Errorf("ok update: unexpected ack error %v", acks["ok"])
+	}
+	if !errors.Is(acks["fail"], errFailed) {
+		t.Errorf("failed update: got ack error %v, want %v", acks["fail"], errFailed)
+	}
+}
+
+func TestClient_Run_JSONLSource(t *testing.T) {
+	t.Parallel()
+
+	var processed atomic.Int32
+	router := gogram.NewRouter()
+	router.HandleOnMessage(func(*gogram.Context, *gogram.Message) error {
+		processed.Add(1)
+		return nil
+	})
+
+	client, err := gogram.NewClient(testToken, gogram.WithRouter(router))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	input := `{"update_id":1,"message":{"text":"a"}}` + "\n\n" + `{"update_id":2,"message":{"text":"b"}}` + "\n"
+	if err = client.Run(t.Context(), gogram.NewJSONLSource(strings.NewReader(input))); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if got := processed.Load(); got != 2 {
+		t.Errorf("processed %d updates, want 2", got)
+	}
+}
+
+func TestClient_Updates_Iterator(t *testing.T) {
+	t.Parallel()
+
+	var offsets []int64
+	var mu sync.Mutex
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var params gogram.GetUpdatesParams
+		_ = json.NewDecoder(r.Body).Decode(&params)
+
+		mu.Lock()
+		offsets = append(offsets, params.Offset)
+		mu.Unlock()
+
+		updates := []gogram.Update{{UpdateID: params.Offset + 1}}
+		resp := gogram.Response{OK: true, Result: json.RawMessage(mustMarshal(t, updates))}
+		_, _ = w.Write(mustMarshal(t, &resp))
+	}))
+	defer server.Close()
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost(strings.TrimPrefix(server.URL, "https://")),
+		gogram.WithHTTPClient(server.Client()),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	var received []int64
+	for update, iterErr := range client.Updates(t.Context(), nil) {
+		if iterErr != nil {
+			t.Fatalf("Updates: %v", iterErr)
+		}
+
+		if len(received) == 0 {
+			for _, startErr := range client.Updates(t.Context(), nil) {
+				if !errors.Is(startErr, gogram.ErrAlreadyStarted) {
+					t.Errorf("nested Updates: got %v, want ErrAlreadyStarted", startErr)
+				}
+			}
+		}
+
+		received = append(received, update.UpdateID)
+		if len(received) == 3 {
+			break
+		}
+	}
+
+	if !slices.Equal(received, []int64{1, 3, 5}) {
+		t.Errorf("received %v, want [1 3 5]", received)
+	}
+	if !slices.Equal(offsets, []int64{0, 2, 4}) {
+		t.Errorf("requested offsets %v, want [0 2 4]", offsets)
+	}
+
+	// The run is finished after break, so the client can be started again.
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	if err = client.Start(ctx, nil); err != nil {
+		t.Errorf("Start after iteration: %v", err)
+	}
+}
+
+// startTestWebhook runs client.StartWebhook on a free local port until the
+// test ends and returns the listen address.
+func startTestWebhook(t *testing.T, client *gogram.Client, params *gogram.SetWebhookParams) string {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	addr := ln.Addr().String()
+	_ = ln.Close()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- client.StartWebhook(ctx, addr, params)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-errCh; err != nil {
+			t.Errorf("StartWebhook: %v", err)
+		}
+	})
+
+	for range 100 {
+		conn, dialErr := net.Dial("tcp", addr)
+		if dialErr == nil {
+			_ = conn.Close()
+			return addr
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("webhook server did not start on %s", addr)
+	return ""
+}
+
+// mustMarshal is a test helper to simplify JSON marshaling checks.
+func mustMarshal(t *testing.T, v any) []byte {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("mustMarshal: %v", err)
+	}
+	return b
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+type failingReadCloser struct {
+	payload []byte
+	sent    bool
+}
+
+func (r *failingReadCloser) Read(p []byte) (int, error) {
+	if r.sent {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	r.sent = true
+	return copy(p, r.payload), nil
+}
+
+func (*failingReadCloser) Close() error { return nil }
+
+func jsonHTTPResponse(t *testing.T, payload any) *http.Response {
+	t.Helper()
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Status:     http.StatusText(http.StatusOK),
+		Header:     make(http.Header),
+		Body:       io.NopCloser(bytes.NewReader(mustMarshal(t, payload))),
+	}
+}
+
+func TestClient_StartPolling_ConflictStop(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		resp := gogram.Response{
+			OK:          false,
+			ErrorCode:   http.StatusConflict,
+			Description: gogram.ErrConflictWithBot.Error(),
+			Result:      json.RawMessage(`null`),
+		}
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write(mustMarshal(t, &resp))
+	}))
+	defer server.Close()
+
+	var states []gogram.ConnectionState
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost(strings.TrimPrefix(server.URL, "https://")),
+		gogram.WithHTTPClient(server.Client()),
+		gogram.WithConflictPolicy(gogram.ConflictStop),
+		gogram.WithConnectionStateHandler(func(_ context.Context, state gogram.ConnectionState, _ error) {
+			states = append(states, state)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 2*time.Second)
+	defer cancel()
+
+	err = client.Start(ctx, nil)
+	if !errors.Is(err, gogram.ErrConflictWithBot) {
+		t.Fatalf("expected ErrConflictWithBot, got %v", err)
+	}
+
+	if want := []gogram.ConnectionState{gogram.ConnectionLost}; !slices.Equal(states, want) {
+		t.Errorf("states = %v, want %v", states, want)
+	}
+}
+
+func TestClient_StartPolling_ConflictTakeOver(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name        string
+		conflict    error
+		getUpdates  int32
+		deleteCalls int32
+	}{
+		{name: "webhook active", conflict: gogram.ErrConflictWebhookActive, getUpdates: 2, deleteCalls: 2},
+		{name: "other instance", conflict: gogram.ErrConflictWithBot, getUpdates: 1, deleteCalls: 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var getUpdates, deleteCalls atomic.Int32
+
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if strings.HasSuffix(r.URL.Path, "/deleteWebhook") {
+					deleteCalls.Add(1)
+					_, _ = w.Write(mustMarshal(t, &gogram.Response{OK: true, Result: json.RawMessage(`true`)}))
+
+					return
+				}
+
+				getUpdates.Add(1)
+
+				resp := gogram.Response{
+					ErrorCode:   http.StatusConflict,
+					Description: tt.conflict.Error(),
+					Result:      json.RawMessage(`null`),
+				}
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write(mustMarshal(t, &resp))
+			}))
+			defer server.Close()
+
+			client, err := gogram.NewClient(testToken,
+				gogram.WithHost(strings.TrimPrefix(server.URL, "https://")),
+				gogram.WithHTTPClient(server.Client()),
+				gogram.WithConflictPolicy(gogram.ConflictTakeOver),
+				gogram.WithPollingBackoff(time.Second, time.Second),
+			)
+			if err != nil {
+				t.Fatalf("NewClient: %v", err)
+			}
+
+			// Shorter than the backoff delay.
+			ctx, cancel := context.WithTimeout(t.Context(), 300*time.Millisecond)
+			defer cancel()
+
+			_ = client.Start(ctx, nil)
+
+			if got := getUpdates.Load(); got != tt.getUpdates {
+				t.Errorf("getUpdates calls = %d, want %d", got, tt.getUpdates)
+			}
+
+			if got := deleteCalls.Load(); got != tt.deleteCalls {
+				t.Errorf("deleteWebhook calls = %d, want %d", got, tt.deleteCalls)
+			}
+		})
+	}
+}
+
+func TestContext_Detach_OutlivesHandler(t *testing.T) {
+	t.Parallel()
+
+	type key struct{}
+
+	parent, cancel := context.WithCancel(t.Context())
+
+	var detached, cloned *gogram.Context
+	router := gogram.NewRouter()
+	router.HandleOnMessage(func(ctx *gogram.Context, _ *gogram.Message) error {
+		ctx.SetValue(key{}, "value")
+		detached = ctx.Detach()
+		cloned = ctx.Clone()
+		return nil
+	})
+
+	client, err := gogram.NewClient(testToken, gogram.WithRouter(router))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	deliveries := make(chan gogram.Delivery, 1)
+	deliveries <- gogram.Delivery{Update: &gogram.Update{UpdateID: 7, Message: &gogram.Message{Text: "hi"}}}
+	close(deliveries)
+
+	if err = client.Run(parent, gogram.ChannelSource(deliveries)); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	cancel()
+
+	if detached == nil {
+		t.Fatal("handler was not invoked")
+	}
+	if got := detached.Update().UpdateID; got != 7 {
+		t.Errorf("detached update id = %d, want 7", got)
+	}
+	if detached.Client() != client {
+		t.Error("detached context lost its client")
+	}
+	if got := detached.Value(key{}); got != "value" {
+		t.Errorf("detached value = %v, want %q", got, "value")
+	}
+	if err = detached.Err(); err != nil {
+		t.Errorf("detached context cancelled: %v", err)
+	}
+	if err = cloned.Err(); !errors.Is(err, context.Canceled) {
+		t.Errorf("cloned context error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestClient_ContextFor_FillsTarget(t *testing.T) {
+	t.Parallel()
+
+	var body map[string]any
+	httpClient := &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Errorf("decode body: %v", err)
+			}
+
+			msg := gogram.Message{MessageID: 10}
+			return jsonHTTPResponse(t, &gogram.Response{OK: true, Result: mustMarshal(t, &msg)}), nil
+		}),
+	}
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost("example.invalid"),
+		gogram.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	ctx := client.ContextFor(t.Context(), gogram.Chat{ID: 100}, &gogram.ContextForOptions{
+		MessageThreadID:      5,
+		BusinessConnectionID: "biz",
+	})
+
+	msg, err := ctx.SendMessage("report")
+	if err != nil {
+		t.Fatalf("SendMessage: %v", err)
+	}
+	if msg.MessageID != 10 {
+		t.Errorf("message id = %d, want 10", msg.MessageID)
+	}
+
+	want := map[string]any{
+		"chat_id":                "100",
+		"message_thread_id":      float64(5),
+		"business_connection_id": "biz",
+		"text":                   "report",
+	}
+	for key, value := range want {
+		if body[key] != value {
+			t.Errorf("%s = %v, want %v", key, body[key], value)
+		}
+	}
+}
+
+func TestClient_CallOptions(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	httpClient := &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			calls.Add(1)
+
+			switch {
+			case strings.HasSuffix(req.URL.Path, "/getMe"):
+				<-req.Context().Done()
+				return nil, req.Context().Err()
+
+			case strings.HasSuffix(req.URL.Path, "/close"):
+				return jsonHTTPResponse(t, &gogram.Response{
+					ErrorCode:   http.StatusTooManyRequests,
+					Description: "Too Many Requests: retry after 1",
+					Result:      json.RawMessage(`null`),
+					Parameters:  &gogram.ResponseParameters{RetryAfter: 1},
+				}), nil
+			}
+
+			return jsonHTTPResponse(t, &gogram.Response{OK: true, Result: json.RawMessage(`true`)}), nil
+		}),
+	}
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost("example.invalid"),
+		gogram.WithHTTPClient(httpClient),
+		gogram.WithTimeout(time.Hour),
+		gogram.WithRPS(1),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	t.Run("Timeout", func(t *testing.T) {
+		ctx := gogram.ContextWithCallTimeout(t.Context(), 50*time.Millisecond)
+		if _, err := client.GetMe(ctx, nil); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected DeadlineExceeded, got %v", err)
+		}
+	})
+
+	t.Run("RetryPolicy", func(t *testing.T) {
+		before := calls.Load()
+
+		ctx := gogram.ContextWithoutRateLimit(t.Context())
+		ctx = gogram.ContextWithCallRetryPolicy(ctx, gogram.RetryPolicy{MaxRetries: 3, MaxDelay: time.Millisecond})
+		if _, err := client.Close(ctx, nil); !errors.Is(err, gogram.ErrTooManyRequests) {
+			t.Errorf("expected ErrTooManyRequests, got %v", err)
+		}
+		if n := calls.Load() - before; n != 1 {
+			t.Errorf("expected no retries, got %d calls", n)
+		}
+	})
+
+	t.Run("SkipRateLimit", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(gogram.ContextWithoutRateLimit(t.Context()), time.Second)
+		defer cancel()
+
+		for range 5 {
+			if _, err := client.LogOut(ctx, nil); err != nil {
+				t.Fatalf("LogOut: %v", err)
+			}
+		}
+	})
+}
+
+func TestClient_Updates_PollTimeoutExtendsHTTPTimeout(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			select {
+			case <-req.Context().Done():
+				return nil, req.Context().Err()
+
+			case <-time.After(100 * time.Millisecond):
+			}
+
+			return jsonHTTPResponse(t, &gogram.Response{
+				OK:     true,
+				Result: json.RawMessage(`[{"update_id":1,"message":{"text":"late"}}]`),
+			}), nil
+		}),
+	}
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost("example.invalid"),
+		gogram.WithHTTPClient(httpClient),
+		gogram.WithTimeout(20*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 2*time.Second)
+	defer cancel()
+
+	for update, err := range client.Updates(ctx, &gogram.GetUpdatesParams{Timeout: 1}) {
+		if err != nil {
+			t.Fatalf("Updates: %v", err)
+		}
+		if update.UpdateID != 1 {
+			t.Errorf("update id = %d, want 1", update.UpdateID)
+		}
+		break
+	}
+}
+
+func TestClient_RequestError_RedactsToken(t *testing.T) {
+	t.Parallel()
+
+	errNetwork := errors.New("connection reset")
+	httpClient := &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(req.URL.Path, "/close") {
+				return jsonHTTPResponse(t, &gogram.Response{
+					ErrorCode:   http.StatusUnauthorized,
+					Description: "Unauthorized",
+					Result:      json.RawMessage(`null`),
+				}), nil
+			}
+
+			return nil, errNetwork
+		}),
+	}
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost("example.invalid"),
+		gogram.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	_, err = client.GetMe(t.Context(), nil)
+	if err == nil || strings.Contains(err.Error(), testToken) {
+		t.Fatalf("expected error without token, got %v", err)
+	}
+	if !errors.Is(err, errNetwork) {
+		t.Errorf("expected wrapped network error, got %v", err)
+	}
+	if reqErr, ok := errors.AsType[*gogram.RequestError](err); !ok || reqErr.Method != "getMe" || reqErr.StatusCode != 0 {
+		t.Errorf("unexpected request error %#v", reqErr)
+	}
+
+	_, err = client.Close(t.Context(), nil)
+	if !errors.Is(err, gogram.ErrUnauthorized) {
+		t.Errorf("expected ErrUnauthorized, got %v", err)
+	}
+	if reqErr, ok := errors.AsType[*gogram.RequestError](err); !ok || reqErr.Method != "close" || reqErr.StatusCode != http.StatusOK {
+		t.Errorf("unexpected request error %#v", reqErr)
+	}
+
+	_, err = client.ReceiveFileReader(t.Context(), &gogram.File{FilePath: "photos/1.jpg"})
+	if err == nil || strings.Contains(err.Error(), testToken) {
+		t.Fatalf("expected file error without token, got %v", err)
+	}
+}
+
+func TestClient_Interceptors(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if got := req.Header.Get("X-Request-Id"); got != "42" {
+				t.Errorf("X-Request-Id = %q, want %q", got, "42")
+			}
+
+			msg := gogram.Message{MessageID: 1}
+			return jsonHTTPResponse(t, &gogram.Response{OK: true, Result: mustMarshal(t, &msg)}), nil
+		}),
+	}
+
+	var order []string
+	logging := func(next gogram.Invoker) gogram.Invoker {
+		return func(ctx context.Context, call *gogram.Call) (json.RawMessage, error) {
+			order = append(order, "log:"+call.Method)
+			return next(ctx, call)
+		}
+	}
+	rewrite := func(next gogram.Invoker) gogram.Invoker {
+		return func(ctx context.Context, call *gogram.Call) (json.RawMessage, error) {
+			if params, ok := call.Params.(*gogram.SendPhotoParams); ok {
+				order = append(order, "mock:"+params.Caption)
+				return json.RawMessage(`{"message_id":2,"date":0,"chat":{"id":1,"type":"private"}}`), nil
+			}
+
+			call.Header = http.Header{"X-Request-Id": {"42"}}
+			return next(ctx, call)
+		}
+	}
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost("example.invalid"),
+		gogram.WithHTTPClient(httpClient),
+		gogram.WithInterceptors(logging, rewrite),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	msg, err := client.SendMessage(t.Context(), &gogram.SendMessageParams{ChatID: "1", Text: "hi"})
+	if err != nil || msg.MessageID != 1 {
+		t.Fatalf("SendMessage: %v, %v", msg, err)
+	}
+
+	msg, err = client.SendPhoto(t.Context(), &gogram.SendPhotoParams{
+		ChatID:  "1",
+		Photo:   gogram.InputFile{File: strings.NewReader("image"), FileName: "photo.jpg"},
+		Caption: "cat",
+	})
+	if err != nil || msg.MessageID != 2 {
+		t.Fatalf("SendPhoto: %v, %v", msg, err)
+	}
+
+	want := []string{"log:sendMessage", "log:sendPhoto", "mock:cat"}
+	if !slices.Equal(order, want) {
+		t.Errorf("order = %v, want %v", order, want)
+	}
+}
+
+func TestClient_MissingResult(t *testing.T) {
+	t.Parallel()
+
+	noResult := &http.Client{
+		Transport: roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			return jsonHTTPResponse(t, json.RawMessage(`{"ok":true}`)), nil
+		}),
+	}
+	nilResult := func(gogram.Invoker) gogram.Invoker {
+		return func(context.Context, *gogram.Call) (json.RawMessage, error) {
+			return nil, nil
+		}
+	}
+
+	for name, opts := range map[string][]gogram.ClientOption{
+		"response":    {gogram.WithHTTPClient(noResult)},
+		"interceptor": {gogram.WithInterceptors(nilResult)},
+	} {
+		client, err := gogram.NewClient(testToken, append(opts, gogram.WithHost("example.invalid"))...)
+		if err != nil {
+			t.Fatalf("NewClient: %v", err)
+		}
+
+		// Only calls delivered in a webhook response succeed without a result.
+		msg, err := client.SendMessage(t.Context(), &gogram.SendMessageParams{ChatID: "1", Text: "hi"})
+		if err == nil {
+			t.Errorf("%s: SendMessage = %+v, want a decode error", name, msg)
+		}
 	}
 }
@@ -0,0 +1,198 @@
+package gogram
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"log/slog"
+	"time"
+)
+
+// Updates returns an iterator over updates received with long polling, for
+// programs that do not need a [Router]:
+//
+//	for update, err := range client.Updates(ctx, nil) {
+//		if err != nil {
+//			log.Println(err) // transient errors are retried with backoff
+//			continue
+//		}
+//		// handle update
+//	}
+//
+// The offset advances once the loop body returns, confirming the update to
+// Telegram with the next request. Iteration ends when ctx is done,
+// [Client.Stop] is called, the loop breaks, or after a fatal error such as
+// [ErrUnauthorized] or [ErrPollingGaveUp]. Failed requests are retried with
+// the backoff set by [WithPollingBackoff]. A client already running yields
+// [ErrAlreadyStarted].
+func (c *Client) Updates(ctx context.Context, params *GetUpdatesParams) iter.Seq2[*Update, error] {
+	return func(yield func(*Update, error) bool) {
+		innerCtx, state, err := c.beginRun(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer c.finishRun(state)
+
+		localParams := c.pollingParams(params)
+		if err = c.loadOffset(innerCtx, &localParams); err != nil {
+			yield(nil, err)
+			return
+		}
+
+		if err = c.poll(innerCtx, &localParams, yield); err != nil {
+			yield(nil, err)
+		}
+	}
+}
+
+// UpdatesChan is like [Client.Updates] but sends updates to a channel that is
+// closed when polling stops. Polling errors are logged and passed to the
+// router's HandleErr.
+func (c *Client) UpdatesChan(ctx context.Context, params *GetUpdatesParams) (<-chan *Update, error) {
+	innerCtx, state, err := c.beginRun(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	localParams := c.pollingParams(params)
+	if err = c.loadOffset(innerCtx, &localParams); err != nil {
+		c.finishRun(state)
+		return nil, err
+	}
+
+	ch := make(chan *Update, localParams.Limit)
+
+	go func() {
+		defer c.finishRun(state)
+		defer close(ch)
+
+		err := c.poll(innerCtx, &localParams, func(update *Update, err error) bool {
+			if err != nil {
+				c.handleErr(innerCtx, err)
+				return true
+			}
+
+			select {
+			case ch <- update:
+				return true
+			case <-innerCtx.Done():
+				return false
+			}
+		})
+		if err != nil {
+			c.handleErr(innerCtx, err)
+		}
+	}()
+
+	return ch, nil
+}
+
+func (c *Client) pollingParams(params *GetUpdatesParams) GetUpdatesParams {
+	var localParams GetUpdatesParams
+
+	if params != nil {
+		localParams = *params
+	}
+
+	if localParams.Limit == 0 {
+		localParams.Limit = defaultUpdates
+	}
+
+	return localParams
+}
+
+// loadOffset resumes from the offset stored in the [UpdateStore] when params
+// has none.
+func (c *Client) loadOffset(ctx context.Context, params *GetUpdatesParams) error {
+	store := c.cfg.updateStore
+	if store == nil || params.Offset != 0 {
+		return nil
+	}
+
+	offset, err := store.LoadOffset(ctx, c.id)
+	if err != nil {
+		return err
+	}
+
+	params.Offset = offset
+
+	return nil
+}
+
+// poll runs the getUpdates loop, passing updates and errors to yield until
+// it returns false, ctx is done or a fatal error occurs. The offset stored in
+// the [UpdateStore] is saved after each batch. It returns the error that
+// stopped polling, if it is not already passed to yield.
+func (c *Client) poll(ctx context.Context, params *GetUpdatesParams, yield func(*Update, error) bool) error {
+	retry := backoff{client: c}
+
+	// Long polling holds the request for params.Timeout seconds, so the HTTP
+	// timeout must exceed it.
+	pollCtx := ctx
+	if params.Timeout > 0 && c.cfg.timeout > 0 {
+		pollCtx = ContextWithCallTimeout(ctx, time.Duration(params.Timeout)*time.Second+c.cfg.timeout)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		default:
+		}
+
+		batch, err := c.GetUpdates(pollCtx, params)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			if !yield(nil, err) {
+				return nil
+			}
+
+			if errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrNotFoundBanned) {
+				c.log(ctx, slog.LevelError, "gogram: polling stopped", slog.Any("error", err))
+				return nil
+			}
+
+			delay, stopErr := retry.fail(ctx, err)
+			if stopErr != nil {
+				c.log(ctx, slog.LevelError, "gogram: polling stopped", slog.Any("error", stopErr))
+				return stopErr
+			}
+
+			c.log(ctx, slog.LevelWarn, "gogram: polling failed",
+				slog.Any("error", err),
+				slog.Int("attempt", retry.attempt),
+				slog.Duration("retry_in", delay),
+			)
+
+			select {
+			case <-ctx.Done():
+				return nil
+
+			case <-time.After(delay):
+			}
+
+			continue
+		}
+
+		retry.succeed(ctx)
+
+		for i := range batch {
+			if !yield(&batch[i], nil) {
+				return nil
+			}
+
+			params.Offset = batch[i].UpdateID + 1
+		}
+
+		if store := c.cfg.updateStore; store != nil && len(batch) != 0 {
+			if err = store.SaveOffset(ctx, c.id, params.Offset); err != nil {
+				c.reportErr(ctx, "gogram: saving offset failed", err)
+			}
+		}
+	}
+}
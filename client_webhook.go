@@ -1,12 +1,19 @@
 package gogram
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -20,27 +27,68 @@ var (
 	ErrInvalidWebhookURL = errors.New("gogram: invalid webhook url")
 	// ErrInvalidWebhookAddr indicates that no local webhook listen address was supplied.
 	ErrInvalidWebhookAddr = errors.New("gogram: invalid webhook listen address")
+	// ErrWebhookCertificate indicates that the webhook certificate cannot be
+	// uploaded again because its reader cannot be rewound.
+	ErrWebhookCertificate = errors.New("gogram: webhook certificate cannot be uploaded again")
 )
 
 // StartWebhook starts an HTTP server that receives Telegram webhook updates.
 // It blocks until ctx is cancelled, then gracefully shuts down.
+// It is a shorthand for [Client.Run] with [Client.WebhookSource].
 //
-// Set up the webhook on Telegram's side with [Client.SetWebhook] before calling this.
+// Set up the webhook on Telegram's side with [Client.SetWebhook] before calling
+// this. StartWebhook may call SetWebhook again with params, see [WebhookSource].
 func (c *Client) StartWebhook(ctx context.Context, addr string, params *SetWebhookParams) error {
+	if _, err := webhookPattern(addr, params); err != nil {
+		return err
+	}
+
+	return c.Run(ctx, c.WebhookSource(addr, params))
+}
+
+var _ UpdateSource = (*WebhookSource)(nil)
+
+// WebhookSource is an [UpdateSource] running an HTTP server on addr that
+// receives Telegram webhook updates at the path of params.URL.
+//
+// The webhook is registered again with params when [WithDropPendingUpdates]
+// is set, or when params.AllowedUpdates is empty and the list derived from
+// the router's registered handlers differs from the one reported by
+// [Client.GetWebhookInfo]. An uploaded params.Certificate is then uploaded
+// again, which requires a reader that can be rewound, such as one from
+// [InputFileFromPath] or an [io.Seeker]. Otherwise the webhook is left as is
+// and [ErrWebhookCertificate] is reported to the router's HandleErr.
+// Rejected requests are reported to the router's HandleErr as [*WebhookError].
+type WebhookSource struct {
+	client *Client
+	addr   string
+	params *SetWebhookParams
+}
+
+// WebhookSource creates a WebhookSource listening on addr.
+func (c *Client) WebhookSource(addr string, params *SetWebhookParams) *WebhookSource {
+	return &WebhookSource{
+		client: c,
+		addr:   addr,
+		params: params,
+	}
+}
+
+func webhookPattern(addr string, params *SetWebhookParams) (string, error) {
 	if addr == "" {
-		return ErrInvalidWebhookAddr
+		return "", ErrInvalidWebhookAddr
 	}
 	if params == nil || params.URL == "" {
-		return ErrInvalidWebhookURL
+		return "", ErrInvalidWebhookURL
 	}
 
 	webhookURL, err := url.Parse(params.URL)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	if webhookURL.Host == "" {
-		return ErrInvalidWebhookURL
+		return "", ErrInvalidWebhookURL
 	}
 
 	pattern := webhookURL.EscapedPath()
@@ -49,24 +97,40 @@ func (c *Client) StartWebhook(ctx context.Context, addr string, params *SetWebho
 	}
 
 	if pattern == "" || pattern[0] != '/' {
-		return ErrInvalidWebhookURL
+		return "", ErrInvalidWebhookURL
 	}
 
-	innerCtx, state, err := c.beginRun(ctx)
+	return pattern, nil
+}
+
+// Receive implements [UpdateSource].
+// Each request is answered once its update is processed, regardless of
+// the ack result, so Telegram does not redeliver failed updates.
+func (s *WebhookSource) Receive(ctx context.Context, deliver DeliverFunc) error {
+	c := s.client
+	params := s.params
+
+	pattern, err := webhookPattern(s.addr, params)
 	if err != nil {
 		return err
 	}
-	defer c.finishRun(state)
+
+	if err = s.register(ctx); err != nil {
+		return err
+	}
 
 	mux := http.NewServeMux()
-	mux.HandleFunc("POST "+pattern, c.webhookHandler(params.SecretToken))
+	mux.HandleFunc("POST "+pattern, c.webhookHandler(params.SecretToken, deliver))
+	for extraPattern, handler := range c.cfg.webhookHandlers {
+		mux.Handle(extraPattern, handler)
+	}
 
 	readHeaderTimeout := defaultWebhookReadHeaderTimeout
 	if c.cfg.timeout > 0 {
 		readHeaderTimeout = min(readHeaderTimeout, c.cfg.timeout)
 	}
 	srv := &http.Server{
-		Addr:              addr,
+		Addr:              s.addr,
 		Handler:           mux,
 		ReadHeaderTimeout: readHeaderTimeout,
 	}
@@ -78,11 +142,12 @@ func (c *Client) StartWebhook(ctx context.Context, addr string, params *SetWebho
 
 	select {
 	case err = <-errCh:
-	case <-innerCtx.Done():
+	case <-ctx.Done():
 		shutdownCtx, shutdownCancel := context.WithTimeout(context.WithoutCancel(ctx), c.cfg.timeout)
 		defer shutdownCancel()
 		shutdownErr := srv.Shutdown(shutdownCtx)
 		if shutdownErr != nil {
+			c.log(ctx, slog.LevelError, "gogram: webhook server shutdown failed", slog.Any("error", shutdownErr))
 			_ = srv.Close()
 		}
 		err = <-errCh
@@ -92,23 +157,111 @@ func (c *Client) StartWebhook(ctx context.Context, addr string, params *SetWebho
 	}
 
 	if errors.Is(err, http.ErrServerClosed) {
-		if ctxErr := ctx.Err(); !errors.Is(ctxErr, context.Canceled) {
-			return ctxErr
+		return nil
+	}
+	return err
+}
+
+// register sets the webhook again when pending updates must be dropped or the
+// allowed updates derived from the router differ from the ones configured on
+// Telegram's side.
+func (s *WebhookSource) register(ctx context.Context) error {
+	c := s.client
+	params := s.params
+
+	allowedUpdates := c.allowedUpdates(ctx, params.AllowedUpdates)
+
+	if !c.cfg.dropPendingUpdates {
+		if slices.Equal(allowedUpdates, params.AllowedUpdates) {
+			return nil
+		}
+
+		info, err := c.GetWebhookInfo(ctx, nil)
+		if err != nil {
+			return err
 		}
+
+		if sameUpdateTypes(info.AllowedUpdates, allowedUpdates) {
+			return nil
+		}
+	}
+
+	if err := rewindInputFile(params.Certificate); err != nil {
+		err = fmt.Errorf("%w: %w", ErrWebhookCertificate, err)
+		c.log(ctx, slog.LevelWarn, "gogram: webhook not registered again", slog.Any("error", err))
+		c.handleErr(ctx, err)
+
+		return nil
+	}
+
+	setParams := *params
+	setParams.AllowedUpdates = allowedUpdates
+	setParams.DropPendingUpdates = c.cfg.dropPendingUpdates
+
+	_, err := c.SetWebhook(ctx, &setParams)
+
+	return err
+}
+
+// rewindInputFile prepares an uploaded file to be sent again. Files opened for
+// each request need nothing, other readers are rewound to their start.
+func rewindInputFile(f *InputFile) error {
+	if f == nil || f.File == nil || f.open != nil {
 		return nil
 	}
+
+	seeker, ok := f.File.(io.Seeker)
+	if !ok {
+		return fmt.Errorf("%T is not an io.Seeker", f.File)
+	}
+
+	_, err := seeker.Seek(0, io.SeekStart)
+
 	return err
 }
 
-func (c *Client) webhookHandler(secretToken string) http.HandlerFunc {
+// sameUpdateTypes reports whether a and b hold the same update types in any order.
+func sameUpdateTypes(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+
+	return slices.Equal(a, b)
+}
+
+func (c *Client) webhookHandler(secretToken string, deliver DeliverFunc) http.HandlerFunc {
+	guard := c.newWebhookGuard()
+
 	return func(w http.ResponseWriter, r *http.Request) {
 		defer func() { _ = r.Body.Close() }()
 
+		addr := guard.clientAddr(r)
+
+		if !guard.allow(addr) {
+			c.rejectWebhook(w, r, addr, http.StatusForbidden, ErrWebhookForbiddenSource)
+			return
+		}
+
 		if secretToken != "" && r.Header.Get("X-Telegram-Bot-Api-Secret-Token") != secretToken {
-			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			c.rejectWebhook(w, r, addr, http.StatusUnauthorized, ErrWebhookSecretToken)
+			return
+		}
+
+		if !isJSONContentType(r.Header.Get("Content-Type")) {
+			c.rejectWebhook(w, r, addr, http.StatusUnsupportedMediaType, ErrWebhookContentType)
 			return
 		}
 
+		if !guard.acquire(addr) {
+			c.rejectWebhook(w, r, addr, http.StatusTooManyRequests, ErrWebhookTooManyConnections)
+			return
+		}
+		defer guard.release(addr)
+
 		buffer := acquireBuffer()
 		defer releaseBuffer(buffer)
 
@@ -117,17 +270,123 @@ func (c *Client) webhookHandler(secretToken string) http.HandlerFunc {
 			if _, ok := errors.AsType[*http.MaxBytesError](copyErr); ok {
 				status = http.StatusRequestEntityTooLarge
 			}
-			http.Error(w, http.StatusText(status), status)
+			c.rejectWebhook(w, r, addr, status, fmt.Errorf("%w: %w", ErrWebhookBody, copyErr))
 			return
 		}
 
 		var update Update
 		if unmarshalErr := json.Unmarshal(buffer.B, &update); unmarshalErr != nil {
-			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			c.rejectWebhook(w, r, addr, http.StatusBadRequest, fmt.Errorf("%w: %w", ErrWebhookBody, unmarshalErr))
+			return
+		}
+
+		reply := new(webhookReply)
+		ctx := context.WithValue(r.Context(), webhookReplyContextKey, reply)
+
+		// Wait for processing so that the webhook reply can be delivered
+		// and Telegram does not send more than max_connections at once.
+		done := make(chan struct{})
+
+		if err := deliver(ctx, &update, func(error) { close(done) }); err != nil {
+			http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+			return
+		}
+
+		<-done
+
+		if body := reply.close(); body != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(body)
 			return
 		}
 
-		c.processUpdate(c.acquireContext(r.Context(), &update))
 		w.WriteHeader(http.StatusOK)
 	}
 }
+
+var (
+	webhookReplyContextKey      = &contextKey{name: "webhook-reply"}
+	webhookReplyScopeContextKey = &contextKey{name: "webhook-reply-scope"}
+)
+
+// errWebhookReplied is returned by Client.call for a call delivered in the
+// webhook response; generated methods turn it into a zero result.
+var errWebhookReplied = errors.New("gogram: call delivered in the webhook response")
+
+// webhookReply holds at most one API call that is returned as the body of
+// the webhook HTTP response instead of being sent to the Bot API.
+type webhookReply struct {
+	mu     sync.Mutex
+	closed bool
+	body   []byte
+}
+
+// webhookReplyScope ties a webhook reply to the first API call made with the
+// Context returned by [Context.WebhookReply].
+type webhookReplyScope struct {
+	reply *webhookReply
+	used  atomic.Bool
+}
+
+// claim offers the call to the webhook reply unless the scope is used up.
+// It reports whether the call must be skipped by the caller.
+func (s *webhookReplyScope) claim(method string, reader io.Reader, contentType string) bool {
+	if s.used.Swap(true) {
+		return false
+	}
+
+	return s.reply.claim(method, reader, contentType)
+}
+
+// claim stores the call as the webhook response if the reply is still empty
+// and open. It reports whether the call must be skipped by the caller.
+func (r *webhookReply) claim(method string, reader io.Reader, contentType string) bool {
+	seeker, ok := reader.(io.ReadSeeker)
+	if !ok || contentType != "application/json" {
+		return false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.body != nil || r.closed {
+		return false
+	}
+
+	payload, err := io.ReadAll(seeker)
+	if err == nil {
+		payload = bytes.TrimSpace(payload)
+	}
+
+	if err != nil || len(payload) < 2 || payload[0] != '{' {
+		// Unreadable or not a JSON object, let the call go through the
+		// Bot API as usual.
+		_, _ = seeker.Seek(0, io.SeekStart)
+		return false
+	}
+
+	body := make([]byte, 0, len(payload)+len(method)+16)
+	body = append(body, `{"method":`...)
+	body = strconv.AppendQuote(body, method)
+
+	if rest := bytes.TrimSpace(payload[1:]); len(rest) != 0 && rest[0] != '}' {
+		body = append(body, ',')
+	}
+
+	body = append(body, payload[1:]...)
+
+	r.body = body
+
+	return true
+}
+
+// close prevents further calls from being claimed and returns the stored body.
+func (r *webhookReply) close() []byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.closed = true
+
+	return r.body
+}
@@ -0,0 +1,211 @@
+package gogram
+
+import (
+	"errors"
+	"log/slog"
+	"mime"
+	"net"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var telegramNetworks = [...]netip.Prefix{
+	netip.MustParsePrefix("149.154.160.0/20"),
+	netip.MustParsePrefix("91.108.4.0/22"),
+}
+
+// TelegramNetworks returns a copy of the subnets Telegram sends webhook
+// requests from.
+//
+// References:
+//   - https://core.telegram.org/bots/webhooks#the-short-version
+func TelegramNetworks() []netip.Prefix {
+	return slices.Clone(telegramNetworks[:])
+}
+
+// Webhook rejection reasons reported through [WebhookError].
+var (
+	// ErrWebhookForbiddenSource indicates a request from an address outside the allowed networks.
+	ErrWebhookForbiddenSource = errors.New("gogram: webhook request from forbidden source")
+	// ErrWebhookSecretToken indicates a missing or wrong X-Telegram-Bot-Api-Secret-Token header.
+	ErrWebhookSecretToken = errors.New("gogram: webhook secret token mismatch")
+	// ErrWebhookContentType indicates a request body that is not application/json.
+	ErrWebhookContentType = errors.New("gogram: webhook unsupported content type")
+	// ErrWebhookTooManyConnections indicates that the per-IP connection limit was reached.
+	ErrWebhookTooManyConnections = errors.New("gogram: webhook too many connections")
+	// ErrWebhookBody indicates an unreadable, oversized or malformed update.
+	ErrWebhookBody = errors.New("gogram: webhook malformed body")
+)
+
+// WebhookError describes a rejected webhook request.
+// It is passed to [Processor.HandleErr] with a context that has no update.
+type WebhookError struct {
+	Err        error
+	RemoteAddr netip.Addr
+	Status     int
+}
+
+func (e *WebhookError) Error() string {
+	return e.Err.Error() + " (" + e.RemoteAddr.String() + ")"
+}
+
+func (e *WebhookError) Unwrap() error {
+	return e.Err
+}
+
+// WithWebhookAllowedNetworks restricts webhook requests to the given networks,
+// typically [TelegramNetworks]. Requests from other addresses are rejected
+// with 403 Forbidden. By default all addresses are allowed.
+func WithWebhookAllowedNetworks(networks ...netip.Prefix) ClientOption {
+	return func(c *Client) {
+		c.cfg.webhookAllowedNetworks = networks
+	}
+}
+
+// WithWebhookTrustedProxies sets the reverse proxies whose X-Forwarded-For
+// header is trusted when resolving the webhook client address.
+func WithWebhookTrustedProxies(networks ...netip.Prefix) ClientOption {
+	return func(c *Client) {
+		c.cfg.webhookTrustedProxies = networks
+	}
+}
+
+// WithWebhookMaxConnsPerIP limits concurrent webhook requests per client address.
+// Requests over the limit are rejected with 429 Too Many Requests. Zero means no limit.
+func WithWebhookMaxConnsPerIP(n int) ClientOption {
+	return func(c *Client) {
+		c.cfg.webhookMaxConnsPerIP = n
+	}
+}
+
+// WithWebhookHandler registers an additional handler on the webhook HTTP
+// server for pattern, in the [http.ServeMux] syntax, e.g. to serve [Metrics]
+// or health checks. These requests bypass the webhook checks.
+func WithWebhookHandler(pattern string, handler http.Handler) ClientOption {
+	return func(c *Client) {
+		if c.cfg.webhookHandlers == nil {
+			c.cfg.webhookHandlers = make(map[string]http.Handler)
+		}
+		c.cfg.webhookHandlers[pattern] = handler
+	}
+}
+
+type webhookGuard struct {
+	allowed  []netip.Prefix
+	trusted  []netip.Prefix
+	maxConns int
+
+	mu    sync.Mutex
+	conns map[netip.Addr]int
+}
+
+func (c *Client) newWebhookGuard() *webhookGuard {
+	return &webhookGuard{
+		allowed:  c.cfg.webhookAllowedNetworks,
+		trusted:  c.cfg.webhookTrustedProxies,
+		maxConns: c.cfg.webhookMaxConnsPerIP,
+		conns:    make(map[netip.Addr]int),
+	}
+}
+
+func containsAddr(networks []netip.Prefix, addr netip.Addr) bool {
+	for _, network := range networks {
+		if network.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// clientAddr resolves the request origin, walking X-Forwarded-For from the
+// right while the hops belong to trusted proxies.
+func (g *webhookGuard) clientAddr(r *http.Request) netip.Addr {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}
+	}
+
+	addr = addr.Unmap()
+
+	if len(g.trusted) == 0 || !containsAddr(g.trusted, addr) {
+		return addr
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+
+		addr = hop.Unmap()
+		if !containsAddr(g.trusted, addr) {
+			break
+		}
+	}
+
+	return addr
+}
+
+func (g *webhookGuard) allow(addr netip.Addr) bool {
+	return len(g.allowed) == 0 || containsAddr(g.allowed, addr)
+}
+
+func (g *webhookGuard) acquire(addr netip.Addr) bool {
+	if g.maxConns <= 0 {
+		return true
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.conns[addr] >= g.maxConns {
+		return false
+	}
+
+	g.conns[addr]++
+
+	return true
+}
+
+func (g *webhookGuard) release(addr netip.Addr) {
+	if g.maxConns <= 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.conns[addr]--; g.conns[addr] <= 0 {
+		delete(g.conns, addr)
+	}
+}
+
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == "application/json"
+}
+
+// rejectWebhook writes the error status and reports the rejection to the router.
+func (c *Client) rejectWebhook(w http.ResponseWriter, r *http.Request, addr netip.Addr, status int, err error) {
+	http.Error(w, http.StatusText(status), status)
+
+	c.log(r.Context(), slog.LevelWarn, "gogram: webhook request rejected",
+		slog.String("remote_addr", addr.String()),
+		slog.Int("status", status),
+		slog.Any("error", err),
+	)
+
+	gogramCtx := c.acquireContext(r.Context(), nil)
+	c.cfg.router.HandleErr(gogramCtx, &WebhookError{Err: err, RemoteAddr: addr, Status: status})
+	c.releaseContext(gogramCtx)
+}
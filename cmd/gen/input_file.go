@@ -5,14 +5,16 @@ import (
 	"fmt"
 )
 
-func inputFileUploadCode(buffer *bytes.Buffer, expr, name string, required bool) {
+// inputFileUploadCode writes a call of fn, createFormFileFromInputFile for
+// files nested in other params or writeFormInputFile for params themselves.
+func inputFileUploadCode(buffer *bytes.Buffer, fn, expr, name string, required bool) {
 	if !required {
 		_, _ = fmt.Fprintf(buffer, "if %s != nil {\n", expr)
 	} else {
 		expr = "&" + expr
 	}
 
-	_, _ = fmt.Fprintf(buffer, `err = createFormFileFromInputFile(
+	_, _ = fmt.Fprintf(buffer, `err = %s(
 	        writer,
 			%s,
 			%s,
@@ -20,7 +22,7 @@ func inputFileUploadCode(buffer *bytes.Buffer, expr, name string, required bool)
 		if err != nil {
 		    return
 		}
-		`, expr, name)
+		`, fn, expr, name)
 
 	if !required {
 		_, _ = buffer.WriteString("}\n")
@@ -49,7 +51,7 @@ func inputFileFieldsCode(buffer *bytes.Buffer, fields []Field, exprPrefix, nameP
 
 		if toType(field.Type, true) == "InputFile" {
 			found = true
-			inputFileUploadCode(buffer, expr, name, field.IsRequired)
+			inputFileUploadCode(buffer, "createFormFileFromInputFile", expr, name, field.IsRequired)
 		}
 	}
 
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// jsonEncodeCode returns the body of appendJSON for a params struct with fields.
+// Fields of basic types are appended directly, others with encoding/json.
+func jsonEncodeCode(fields []Field) string {
+	buffer := new(bytes.Buffer)
+
+	buffer.WriteString("if r == nil {\nreturn append(b, \"null\"...), nil\n}\n\n")
+
+	if len(fields) == 0 {
+		buffer.WriteString("return append(b, \"{}\"...), nil")
+		return buffer.String()
+	}
+
+	needErr := false
+	for _, field := range fields {
+		if _, ok := jsonBasicAppend(toType(field.Type, field.IsRequired)); !ok {
+			needErr = true
+		}
+	}
+
+	if needErr {
+		buffer.WriteString("var err error\n\n")
+	}
+
+	buffer.WriteString("b = append(b, '{')\nstart := len(b)\n\n")
+
+	for _, field := range fields {
+		fieldType := toType(field.Type, field.IsRequired)
+		fieldExpr := "r." + toTitle(field.Name)
+
+		if !field.IsRequired {
+			_, _ = fmt.Fprintf(buffer, "if %s {\n", jsonNonEmptyExpr(fieldExpr, fieldType))
+		}
+
+		_, _ = fmt.Fprintf(buffer, "b = appendJSONKey(b, start, %q)\n", field.Name)
+
+		if appendFunc, ok := jsonBasicAppend(fieldType); ok {
+			_, _ = fmt.Fprintf(buffer, "b = %s(b, %s)\n", appendFunc, fieldExpr)
+		} else {
+			if !strings.HasPrefix(fieldType, "*") && !strings.HasPrefix(fieldType, "[]") {
+				// Use pointer to trigger pointer-receiver MarshalJSON on union types.
+				fieldExpr = "&" + fieldExpr
+			}
+
+			_, _ = fmt.Fprintf(buffer, "if b, err = appendJSONValue(b, %s); err != nil {\nreturn b, err\n}\n", fieldExpr)
+		}
+
+		if !field.IsRequired {
+			buffer.WriteString("}\n")
+		}
+
+		buffer.WriteString("\n")
+	}
+
+	buffer.WriteString("return append(b, '}'), nil")
+
+	return buffer.String()
+}
+
+// jsonBasicAppend returns the append function for a basic Go type. Floats are
+// left to encoding/json, which rejects NaN and infinities.
+func jsonBasicAppend(goType string) (string, bool) {
+	switch goType {
+	case "string":
+		return "appendJSONString", true
+
+	case "int64":
+		return "appendJSONInt", true
+
+	case "bool":
+		return "appendJSONBool", true
+	}
+
+	return "", false
+}
+
+// jsonNonEmptyExpr returns the condition under which an omitempty field is encoded.
+func jsonNonEmptyExpr(expr, goType string) string {
+	switch {
+	case goType == "string":
+		return expr + ` != ""`
+
+	case goType == "int64", goType == "float64":
+		return expr + " != 0"
+
+	case goType == "bool":
+		return expr
+
+	case strings.HasPrefix(goType, "[]"):
+		return "len(" + expr + ") != 0"
+
+	case strings.HasPrefix(goType, "*"):
+		return expr + " != nil"
+	}
+
+	// Struct values are never omitted by encoding/json.
+	return "true"
+}
+
+// jsonDecodeTypes returns the types given a generated UnmarshalJSON: the
+// struct types reachable from Update, which holds Message, without going
+// through types with subtypes, whose UnmarshalJSON picks the subtype.
+func jsonDecodeTypes(types map[string]Type) []Type {
+	seen := make(map[string]bool)
+
+	var visit func(name string)
+	visit = func(name string) {
+		typ, ok := types[name]
+		if !ok || seen[name] || len(typ.Subtypes) != 0 || typ.CanBeString || typ.CanBeArray != "" {
+			return
+		}
+
+		seen[name] = true
+
+		for _, field := range typ.Fields {
+			visit(strings.TrimLeft(toType(field.Type, true), "[]"))
+		}
+	}
+
+	visit("Update")
+
+	decoded := make([]Type, 0, len(seen))
+	for _, name := range slices.Sorted(maps.Keys(seen)) {
+		decoded = append(decoded, types[name])
+	}
+
+	return decoded
+}
+
+// jsonDecodeCode returns the body of decodeJSONMember for typ. Fields of basic
+// types and of types with a generated UnmarshalJSON are decoded directly,
+// others with encoding/json.
+func jsonDecodeCode(typ Type, types map[string]Type) string {
+	if len(typ.Fields) == 0 {
+		return "return nil"
+	}
+
+	decoded := make(map[string]bool)
+	for _, t := range jsonDecodeTypes(types) {
+		decoded[t.Name] = true
+	}
+
+	buffer := new(bytes.Buffer)
+
+	buffer.WriteString("switch string(key) {\n")
+
+	for i, field := range typ.Fields {
+		fieldType := toType(field.Type, field.IsRequired)
+		fieldExpr := "r." + toTitle(field.Name)
+
+		if i != 0 {
+			buffer.WriteString("\n")
+		}
+
+		_, _ = fmt.Fprintf(buffer, "case %q:\n", field.Name)
+
+		switch elem, isArray := strings.CutPrefix(fieldType, "[]"); {
+		case jsonBasicDecode(fieldType) != "":
+			_, _ = fmt.Fprintf(buffer, "return %s(value, &%s)\n", jsonBasicDecode(fieldType), fieldExpr)
+
+		case isArray && decoded[elem]:
+			_, _ = fmt.Fprintf(buffer, "return decodeJSONArray(value, &%s)\n", fieldExpr)
+
+		case strings.HasPrefix(fieldType, "*") && decoded[fieldType[1:]]:
+			_, _ = fmt.Fprintf(buffer, "return decodeJSONObject(value, &%s)\n", fieldExpr)
+
+		case decoded[fieldType]:
+			_, _ = fmt.Fprintf(buffer, "return %s.UnmarshalJSON(value)\n", fieldExpr)
+
+		default:
+			_, _ = fmt.Fprintf(buffer, "return decodeJSONValue(value, &%s)\n", fieldExpr)
+		}
+	}
+
+	buffer.WriteString("}\n\nreturn nil")
+
+	return buffer.String()
+}
+
+// jsonBasicDecode returns the decode function for a basic Go type. Floats are
+// left to encoding/json.
+func jsonBasicDecode(goType string) string {
+	switch goType {
+	case "string":
+		return "decodeJSONString"
+
+	case "int64":
+		return "decodeJSONInt"
+
+	case "bool":
+		return "decodeJSONBool"
+	}
+
+	return ""
+}
@@ -33,7 +33,7 @@ func (field Field) FileUploadCode(types map[string]Type) string {
 	fieldExpr := "params." + fieldTitle
 
 	if fieldType == "InputFile" {
-		inputFileUploadCode(buffer, fieldExpr, fmt.Sprintf("%q", field.Name), field.IsRequired)
+		inputFileUploadCode(buffer, "writeFormInputFile", fieldExpr, fmt.Sprintf("%q", field.Name), field.IsRequired)
 
 		if buffer.Len() != 0 {
 			return buffer.String()
@@ -267,6 +267,7 @@ func main() {
 		{path: "./methods.gen.go", template: "methods.gen.gotmpl", data: info},
 		{path: "./context.gen.go", template: "context.gen.gotmpl", data: info},
 		{path: "./router.gen.go", template: "router.gen.gotmpl", data: info},
+		{path: "./json.gen.go", template: "json.gen.gotmpl", data: info},
 	}
 
 	for _, output := range outputs {
@@ -26,6 +26,10 @@ func parseTemplates() *template.Template {
 		"toType":       toType,
 		"toMake":       toMake,
 		"toLowerFirst": toLowerFirst,
+
+		"jsonEncodeCode":  jsonEncodeCode,
+		"jsonDecodeTypes": jsonDecodeTypes,
+		"jsonDecodeCode":  jsonDecodeCode,
 	}
 
 	tmpl, err := template.New("").Option("missingkey=error").Funcs(funcMap).ParseFS(subFS, "*.gotmpl")
@@ -71,6 +71,8 @@ func (ctx *Context) findHandlerOn() handleOn {
 
 // User returns a user associated with the current update.
 func (ctx *Context) User() *User {
+	ctx.checkReleased()
+
 	if ctx.update == nil {
 		return nil
 	}
@@ -125,6 +127,8 @@ func (ctx *Context) User() *User {
 
 // Chat returns a chat associated with the current update.
 func (ctx *Context) Chat() *Chat {
+	ctx.checkReleased()
+
 	if ctx.update == nil {
 		return nil
 	}
@@ -170,6 +174,8 @@ func (ctx *Context) Chat() *Chat {
 
 // Message returns the message associated with the current update, if any.
 func (ctx *Context) Message() *Message {
+	ctx.checkReleased()
+
 	if ctx.update == nil {
 		return nil
 	}
@@ -222,6 +228,8 @@ func (ctx *Context) AddStickerToSet(
 	sticker InputSticker,
 	opts ...AddStickerToSetOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &AddStickerToSetParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -253,6 +261,8 @@ func (ctx *Context) AddStickerToSet(
 func (ctx *Context) AnswerCallbackQuery(
 	opts ...AnswerCallbackQueryOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &AnswerCallbackQueryParams{
 		CallbackQueryID: func(ctx *Context) string {
 			if ctx.update == nil {
@@ -284,6 +294,8 @@ func (ctx *Context) AnswerChatJoinRequestQuery(
 	result string,
 	opts ...AnswerChatJoinRequestQueryOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &AnswerChatJoinRequestQueryParams{
 		ChatJoinRequestQueryID: chatJoinRequestQueryID,
 		Result:                 result,
@@ -306,7 +318,9 @@ func (ctx *Context) AnswerChatJoinRequestQuery(
 func (ctx *Context) AnswerGuestQuery(
 	result InlineQueryResult,
 	opts ...AnswerGuestQueryOption,
-) error {
+) (*SentGuestMessage, error) {
+	ctx.checkReleased()
+
 	params := &AnswerGuestQueryParams{
 		GuestQueryID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -322,9 +336,7 @@ func (ctx *Context) AnswerGuestQuery(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.AnswerGuestQuery(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.AnswerGuestQuery(context.WithoutCancel(ctx.context), params)
 }
 
 // AnswerInlineQuery calls Client.AnswerInlineQuery with context-derived defaults.
@@ -336,6 +348,8 @@ func (ctx *Context) AnswerInlineQuery(
 	results []InlineQueryResult,
 	opts ...AnswerInlineQueryOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &AnswerInlineQueryParams{
 		InlineQueryID: func(ctx *Context) string {
 			if ctx.update == nil {
@@ -371,6 +385,8 @@ func (ctx *Context) AnswerPreCheckoutQuery(
 	ok bool,
 	opts ...AnswerPreCheckoutQueryOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &AnswerPreCheckoutQueryParams{
 		PreCheckoutQueryID: func(ctx *Context) string {
 			if ctx.update == nil {
@@ -405,6 +421,8 @@ func (ctx *Context) AnswerShippingQuery(
 	ok bool,
 	opts ...AnswerShippingQueryOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &AnswerShippingQueryParams{
 		ShippingQueryID: func(ctx *Context) string {
 			if ctx.update == nil {
@@ -439,7 +457,9 @@ func (ctx *Context) AnswerWebAppQuery(
 	webAppQueryID string,
 	result InlineQueryResult,
 	opts ...AnswerWebAppQueryOption,
-) error {
+) (*SentWebAppMessage, error) {
+	ctx.checkReleased()
+
 	params := &AnswerWebAppQueryParams{
 		WebAppQueryID: webAppQueryID,
 		Result:        result,
@@ -448,9 +468,7 @@ func (ctx *Context) AnswerWebAppQuery(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.AnswerWebAppQuery(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.AnswerWebAppQuery(context.WithoutCancel(ctx.context), params)
 }
 
 // ApproveChatJoinRequest calls Client.ApproveChatJoinRequest with context-derived defaults.
@@ -461,6 +479,8 @@ func (ctx *Context) AnswerWebAppQuery(
 func (ctx *Context) ApproveChatJoinRequest(
 	opts ...ApproveChatJoinRequestOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &ApproveChatJoinRequestParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -496,6 +516,8 @@ func (ctx *Context) ApproveChatJoinRequest(
 func (ctx *Context) ApproveSuggestedPost(
 	opts ...ApproveSuggestedPostOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &ApproveSuggestedPostParams{
 		ChatID: func(ctx *Context) int64 {
 			c := ctx.Chat()
@@ -534,6 +556,8 @@ func (ctx *Context) ApproveSuggestedPost(
 func (ctx *Context) BanChatMember(
 	opts ...BanChatMemberOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &BanChatMemberParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -573,6 +597,8 @@ func (ctx *Context) BanChatSenderChat(
 	senderChatID int64,
 	opts ...BanChatSenderChatOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &BanChatSenderChatParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -603,6 +629,8 @@ func (ctx *Context) BanChatSenderChat(
 func (ctx *Context) Close(
 	opts ...CloseOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &CloseParams{}
 
 	params.Option(opts...)
@@ -621,6 +649,8 @@ func (ctx *Context) Close(
 func (ctx *Context) CloseForumTopic(
 	opts ...CloseForumTopicOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &CloseForumTopicParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -656,6 +686,8 @@ func (ctx *Context) CloseForumTopic(
 func (ctx *Context) CloseGeneralForumTopic(
 	opts ...CloseGeneralForumTopicOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &CloseGeneralForumTopicParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -684,6 +716,8 @@ func (ctx *Context) ConvertGiftToStars(
 	ownedGiftID string,
 	opts ...ConvertGiftToStarsOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &ConvertGiftToStarsParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -718,7 +752,9 @@ func (ctx *Context) ConvertGiftToStars(
 func (ctx *Context) CopyMessage(
 	fromChatID string,
 	opts ...CopyMessageOption,
-) error {
+) (*MessageId, error) {
+	ctx.checkReleased()
+
 	params := &CopyMessageParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -770,9 +806,7 @@ func (ctx *Context) CopyMessage(
 	}
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.CopyMessage(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.CopyMessage(context.WithoutCancel(ctx.context), params)
 }
 
 // CopyMessages calls Client.CopyMessages with context-derived defaults.
@@ -792,7 +826,9 @@ func (ctx *Context) CopyMessages(
 	fromChatID string,
 	messageIDs []int64,
 	opts ...CopyMessagesOption,
-) error {
+) ([]MessageId, error) {
+	ctx.checkReleased()
+
 	params := &CopyMessagesParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -829,9 +865,7 @@ func (ctx *Context) CopyMessages(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.CopyMessages(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.CopyMessages(context.WithoutCancel(ctx.context), params)
 }
 
 // CreateChatInviteLink calls Client.CreateChatInviteLink with context-derived defaults.
@@ -845,7 +879,9 @@ func (ctx *Context) CopyMessages(
 // [ChatInviteLink]: https://core.telegram.org/bots/api#chatinvitelink
 func (ctx *Context) CreateChatInviteLink(
 	opts ...CreateChatInviteLinkOption,
-) error {
+) (*ChatInviteLink, error) {
+	ctx.checkReleased()
+
 	params := &CreateChatInviteLinkParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -860,9 +896,7 @@ func (ctx *Context) CreateChatInviteLink(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.CreateChatInviteLink(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.CreateChatInviteLink(context.WithoutCancel(ctx.context), params)
 }
 
 // CreateChatSubscriptionInviteLink calls Client.CreateChatSubscriptionInviteLink with context-derived defaults.
@@ -880,7 +914,9 @@ func (ctx *Context) CreateChatSubscriptionInviteLink(
 	subscriptionPeriod int64,
 	subscriptionPrice int64,
 	opts ...CreateChatSubscriptionInviteLinkOption,
-) error {
+) (*ChatInviteLink, error) {
+	ctx.checkReleased()
+
 	params := &CreateChatSubscriptionInviteLinkParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -897,9 +933,7 @@ func (ctx *Context) CreateChatSubscriptionInviteLink(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.CreateChatSubscriptionInviteLink(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.CreateChatSubscriptionInviteLink(context.WithoutCancel(ctx.context), params)
 }
 
 // CreateForumTopic calls Client.CreateForumTopic with context-derived defaults.
@@ -912,7 +946,9 @@ func (ctx *Context) CreateChatSubscriptionInviteLink(
 func (ctx *Context) CreateForumTopic(
 	name string,
 	opts ...CreateForumTopicOption,
-) error {
+) (*ForumTopic, error) {
+	ctx.checkReleased()
+
 	params := &CreateForumTopicParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -928,9 +964,7 @@ func (ctx *Context) CreateForumTopic(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.CreateForumTopic(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.CreateForumTopic(context.WithoutCancel(ctx.context), params)
 }
 
 // CreateInvoiceLink calls Client.CreateInvoiceLink with context-derived defaults.
@@ -944,7 +978,9 @@ func (ctx *Context) CreateInvoiceLink(
 	currency string,
 	prices []LabeledPrice,
 	opts ...CreateInvoiceLinkOption,
-) error {
+) (string, error) {
+	ctx.checkReleased()
+
 	params := &CreateInvoiceLinkParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -964,9 +1000,7 @@ func (ctx *Context) CreateInvoiceLink(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.CreateInvoiceLink(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.CreateInvoiceLink(context.WithoutCancel(ctx.context), params)
 }
 
 // CreateNewStickerSet calls Client.CreateNewStickerSet with context-derived defaults.
@@ -980,6 +1014,8 @@ func (ctx *Context) CreateNewStickerSet(
 	stickers []InputSticker,
 	opts ...CreateNewStickerSetOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &CreateNewStickerSetParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -1010,6 +1046,8 @@ func (ctx *Context) CreateNewStickerSet(
 func (ctx *Context) DeclineChatJoinRequest(
 	opts ...DeclineChatJoinRequestOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &DeclineChatJoinRequestParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -1045,6 +1083,8 @@ func (ctx *Context) DeclineChatJoinRequest(
 func (ctx *Context) DeclineSuggestedPost(
 	opts ...DeclineSuggestedPostOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &DeclineSuggestedPostParams{
 		ChatID: func(ctx *Context) int64 {
 			c := ctx.Chat()
@@ -1080,6 +1120,8 @@ func (ctx *Context) DeclineSuggestedPost(
 func (ctx *Context) DeleteAllMessageReactions(
 	opts ...DeleteAllMessageReactionsOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &DeleteAllMessageReactionsParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -1116,6 +1158,8 @@ func (ctx *Context) DeleteBusinessMessages(
 	messageIDs []int64,
 	opts ...DeleteBusinessMessagesOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &DeleteBusinessMessagesParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -1145,6 +1189,8 @@ func (ctx *Context) DeleteBusinessMessages(
 func (ctx *Context) DeleteChatPhoto(
 	opts ...DeleteChatPhotoOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &DeleteChatPhotoParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -1175,6 +1221,8 @@ func (ctx *Context) DeleteChatPhoto(
 func (ctx *Context) DeleteChatStickerSet(
 	opts ...DeleteChatStickerSetOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &DeleteChatStickerSetParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -1203,6 +1251,8 @@ func (ctx *Context) DeleteEphemeralMessage(
 	receiverUserID int64,
 	opts ...DeleteEphemeralMessageOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &DeleteEphemeralMessageParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -1239,6 +1289,8 @@ func (ctx *Context) DeleteEphemeralMessage(
 func (ctx *Context) DeleteForumTopic(
 	opts ...DeleteForumTopicOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &DeleteForumTopicParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -1282,6 +1334,8 @@ func (ctx *Context) DeleteForumTopic(
 func (ctx *Context) DeleteMessage(
 	opts ...DeleteMessageOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &DeleteMessageParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -1317,6 +1371,8 @@ func (ctx *Context) DeleteMessage(
 func (ctx *Context) DeleteMessageReaction(
 	opts ...DeleteMessageReactionOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &DeleteMessageReactionParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -1361,6 +1417,8 @@ func (ctx *Context) DeleteMessages(
 	messageIDs []int64,
 	opts ...DeleteMessagesOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &DeleteMessagesParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -1391,6 +1449,8 @@ func (ctx *Context) DeleteMessages(
 func (ctx *Context) DeleteMyCommands(
 	opts ...DeleteMyCommandsOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &DeleteMyCommandsParams{}
 
 	params.Option(opts...)
@@ -1409,6 +1469,8 @@ func (ctx *Context) DeleteStickerFromSet(
 	sticker string,
 	opts ...DeleteStickerFromSetOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &DeleteStickerFromSetParams{
 		Sticker: sticker,
 	}
@@ -1429,6 +1491,8 @@ func (ctx *Context) DeleteStickerSet(
 	name string,
 	opts ...DeleteStickerSetOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &DeleteStickerSetParams{
 		Name: name,
 	}
@@ -1450,6 +1514,8 @@ func (ctx *Context) DeleteStory(
 	storyID int64,
 	opts ...DeleteStoryOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &DeleteStoryParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -1479,6 +1545,8 @@ func (ctx *Context) DeleteStory(
 func (ctx *Context) DeleteWebhook(
 	opts ...DeleteWebhookOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &DeleteWebhookParams{}
 
 	params.Option(opts...)
@@ -1499,7 +1567,9 @@ func (ctx *Context) DeleteWebhook(
 func (ctx *Context) EditChatInviteLink(
 	inviteLink string,
 	opts ...EditChatInviteLinkOption,
-) error {
+) (*ChatInviteLink, error) {
+	ctx.checkReleased()
+
 	params := &EditChatInviteLinkParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -1515,9 +1585,7 @@ func (ctx *Context) EditChatInviteLink(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.EditChatInviteLink(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.EditChatInviteLink(context.WithoutCancel(ctx.context), params)
 }
 
 // EditChatSubscriptionInviteLink calls Client.EditChatSubscriptionInviteLink with context-derived defaults.
@@ -1530,7 +1598,9 @@ func (ctx *Context) EditChatInviteLink(
 func (ctx *Context) EditChatSubscriptionInviteLink(
 	inviteLink string,
 	opts ...EditChatSubscriptionInviteLinkOption,
-) error {
+) (*ChatInviteLink, error) {
+	ctx.checkReleased()
+
 	params := &EditChatSubscriptionInviteLinkParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -1546,9 +1616,7 @@ func (ctx *Context) EditChatSubscriptionInviteLink(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.EditChatSubscriptionInviteLink(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.EditChatSubscriptionInviteLink(context.WithoutCancel(ctx.context), params)
 }
 
 // EditEphemeralMessageCaption calls Client.EditEphemeralMessageCaption with context-derived defaults.
@@ -1560,6 +1628,8 @@ func (ctx *Context) EditEphemeralMessageCaption(
 	receiverUserID int64,
 	opts ...EditEphemeralMessageCaptionOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &EditEphemeralMessageCaptionParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -1606,6 +1676,8 @@ func (ctx *Context) EditEphemeralMessageMedia(
 	media InputMedia,
 	opts ...EditEphemeralMessageMediaOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &EditEphemeralMessageMediaParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -1650,6 +1722,8 @@ func (ctx *Context) EditEphemeralMessageReplyMarkup(
 	receiverUserID int64,
 	opts ...EditEphemeralMessageReplyMarkupOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &EditEphemeralMessageReplyMarkupParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -1688,6 +1762,8 @@ func (ctx *Context) EditEphemeralMessageText(
 	text string,
 	opts ...EditEphemeralMessageTextOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &EditEphemeralMessageTextParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -1733,6 +1809,8 @@ func (ctx *Context) EditEphemeralMessageText(
 func (ctx *Context) EditForumTopic(
 	opts ...EditForumTopicOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &EditForumTopicParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -1769,6 +1847,8 @@ func (ctx *Context) EditGeneralForumTopic(
 	name string,
 	opts ...EditGeneralForumTopicOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &EditGeneralForumTopicParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -1798,7 +1878,9 @@ func (ctx *Context) EditGeneralForumTopic(
 // [Message]: https://core.telegram.org/bots/api#message
 func (ctx *Context) EditMessageCaption(
 	opts ...EditMessageCaptionOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &EditMessageCaptionParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -1844,9 +1926,7 @@ func (ctx *Context) EditMessageCaption(
 	}
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.EditMessageCaption(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.EditMessageCaption(context.WithoutCancel(ctx.context), params)
 }
 
 // EditMessageChecklist calls Client.EditMessageChecklist with context-derived defaults.
@@ -1858,7 +1938,9 @@ func (ctx *Context) EditMessageCaption(
 func (ctx *Context) EditMessageChecklist(
 	checklist InputChecklist,
 	opts ...EditMessageChecklistOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &EditMessageChecklistParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -1890,9 +1972,7 @@ func (ctx *Context) EditMessageChecklist(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.EditMessageChecklist(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.EditMessageChecklist(context.WithoutCancel(ctx.context), params)
 }
 
 // EditMessageLiveLocation calls Client.EditMessageLiveLocation with context-derived defaults.
@@ -1907,7 +1987,9 @@ func (ctx *Context) EditMessageLiveLocation(
 	latitude float64,
 	longitude float64,
 	opts ...EditMessageLiveLocationOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &EditMessageLiveLocationParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -1947,9 +2029,7 @@ func (ctx *Context) EditMessageLiveLocation(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.EditMessageLiveLocation(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.EditMessageLiveLocation(context.WithoutCancel(ctx.context), params)
 }
 
 // EditMessageMedia calls Client.EditMessageMedia with context-derived defaults.
@@ -1964,7 +2044,9 @@ func (ctx *Context) EditMessageLiveLocation(
 func (ctx *Context) EditMessageMedia(
 	media InputMedia,
 	opts ...EditMessageMediaOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &EditMessageMediaParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -2009,9 +2091,7 @@ func (ctx *Context) EditMessageMedia(
 	}
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.EditMessageMedia(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.EditMessageMedia(context.WithoutCancel(ctx.context), params)
 }
 
 // EditMessageReplyMarkup calls Client.EditMessageReplyMarkup with context-derived defaults.
@@ -2023,7 +2103,9 @@ func (ctx *Context) EditMessageMedia(
 // [Message]: https://core.telegram.org/bots/api#message
 func (ctx *Context) EditMessageReplyMarkup(
 	opts ...EditMessageReplyMarkupOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &EditMessageReplyMarkupParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -2061,9 +2143,7 @@ func (ctx *Context) EditMessageReplyMarkup(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.EditMessageReplyMarkup(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.EditMessageReplyMarkup(context.WithoutCancel(ctx.context), params)
 }
 
 // EditMessageText calls Client.EditMessageText with context-derived defaults.
@@ -2076,7 +2156,9 @@ func (ctx *Context) EditMessageReplyMarkup(
 // [Message]: https://core.telegram.org/bots/api#message
 func (ctx *Context) EditMessageText(
 	opts ...EditMessageTextOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &EditMessageTextParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -2122,9 +2204,7 @@ func (ctx *Context) EditMessageText(
 	}
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.EditMessageText(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.EditMessageText(context.WithoutCancel(ctx.context), params)
 }
 
 // EditStory calls Client.EditStory with context-derived defaults.
@@ -2138,7 +2218,9 @@ func (ctx *Context) EditStory(
 	storyID int64,
 	content InputStoryContent,
 	opts ...EditStoryOption,
-) error {
+) (*Story, error) {
+	ctx.checkReleased()
+
 	params := &EditStoryParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -2163,9 +2245,7 @@ func (ctx *Context) EditStory(
 	}
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.EditStory(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.EditStory(context.WithoutCancel(ctx.context), params)
 }
 
 // EditUserStarSubscription calls Client.EditUserStarSubscription with context-derived defaults.
@@ -2177,6 +2257,8 @@ func (ctx *Context) EditUserStarSubscription(
 	isCanceled bool,
 	opts ...EditUserStarSubscriptionOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &EditUserStarSubscriptionParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -2205,7 +2287,9 @@ func (ctx *Context) EditUserStarSubscription(
 // Returns the new invite link as String on success.
 func (ctx *Context) ExportChatInviteLink(
 	opts ...ExportChatInviteLinkOption,
-) error {
+) (string, error) {
+	ctx.checkReleased()
+
 	params := &ExportChatInviteLinkParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -2220,9 +2304,7 @@ func (ctx *Context) ExportChatInviteLink(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.ExportChatInviteLink(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.ExportChatInviteLink(context.WithoutCancel(ctx.context), params)
 }
 
 // ForwardMessage calls Client.ForwardMessage with context-derived defaults.
@@ -2235,7 +2317,9 @@ func (ctx *Context) ExportChatInviteLink(
 func (ctx *Context) ForwardMessage(
 	fromChatID string,
 	opts ...ForwardMessageOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &ForwardMessageParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -2279,9 +2363,7 @@ func (ctx *Context) ForwardMessage(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.ForwardMessage(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.ForwardMessage(context.WithoutCancel(ctx.context), params)
 }
 
 // ForwardMessages calls Client.ForwardMessages with context-derived defaults.
@@ -2297,7 +2379,9 @@ func (ctx *Context) ForwardMessages(
 	fromChatID string,
 	messageIDs []int64,
 	opts ...ForwardMessagesOption,
-) error {
+) ([]MessageId, error) {
+	ctx.checkReleased()
+
 	params := &ForwardMessagesParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -2334,9 +2418,7 @@ func (ctx *Context) ForwardMessages(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.ForwardMessages(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.ForwardMessages(context.WithoutCancel(ctx.context), params)
 }
 
 // GetAvailableGifts calls Client.GetAvailableGifts with context-derived defaults.
@@ -2348,15 +2430,15 @@ func (ctx *Context) ForwardMessages(
 // [Gifts]: https://core.telegram.org/bots/api#gifts
 func (ctx *Context) GetAvailableGifts(
 	opts ...GetAvailableGiftsOption,
-) error {
+) (*Gifts, error) {
+	ctx.checkReleased()
+
 	params := &GetAvailableGiftsParams{}
 
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetAvailableGifts(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetAvailableGifts(context.WithoutCancel(ctx.context), params)
 }
 
 // GetBusinessAccountGifts calls Client.GetBusinessAccountGifts with context-derived defaults.
@@ -2368,7 +2450,9 @@ func (ctx *Context) GetAvailableGifts(
 // [OwnedGifts]: https://core.telegram.org/bots/api#ownedgifts
 func (ctx *Context) GetBusinessAccountGifts(
 	opts ...GetBusinessAccountGiftsOption,
-) error {
+) (*OwnedGifts, error) {
+	ctx.checkReleased()
+
 	params := &GetBusinessAccountGiftsParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -2383,9 +2467,7 @@ func (ctx *Context) GetBusinessAccountGifts(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetBusinessAccountGifts(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetBusinessAccountGifts(context.WithoutCancel(ctx.context), params)
 }
 
 // GetBusinessAccountStarBalance calls Client.GetBusinessAccountStarBalance with context-derived defaults.
@@ -2397,7 +2479,9 @@ func (ctx *Context) GetBusinessAccountGifts(
 // [StarAmount]: https://core.telegram.org/bots/api#staramount
 func (ctx *Context) GetBusinessAccountStarBalance(
 	opts ...GetBusinessAccountStarBalanceOption,
-) error {
+) (*StarAmount, error) {
+	ctx.checkReleased()
+
 	params := &GetBusinessAccountStarBalanceParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -2412,9 +2496,7 @@ func (ctx *Context) GetBusinessAccountStarBalance(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetBusinessAccountStarBalance(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetBusinessAccountStarBalance(context.WithoutCancel(ctx.context), params)
 }
 
 // GetBusinessConnection calls Client.GetBusinessConnection with context-derived defaults.
@@ -2425,7 +2507,9 @@ func (ctx *Context) GetBusinessAccountStarBalance(
 // [BusinessConnection]: https://core.telegram.org/bots/api#businessconnection
 func (ctx *Context) GetBusinessConnection(
 	opts ...GetBusinessConnectionOption,
-) error {
+) (*BusinessConnection, error) {
+	ctx.checkReleased()
+
 	params := &GetBusinessConnectionParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -2440,9 +2524,7 @@ func (ctx *Context) GetBusinessConnection(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetBusinessConnection(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetBusinessConnection(context.WithoutCancel(ctx.context), params)
 }
 
 // GetChat calls Client.GetChat with context-derived defaults.
@@ -2453,7 +2535,9 @@ func (ctx *Context) GetBusinessConnection(
 // [ChatFullInfo]: https://core.telegram.org/bots/api#chatfullinfo
 func (ctx *Context) GetChat(
 	opts ...GetChatOption,
-) error {
+) (*ChatFullInfo, error) {
+	ctx.checkReleased()
+
 	params := &GetChatParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -2468,9 +2552,7 @@ func (ctx *Context) GetChat(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetChat(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetChat(context.WithoutCancel(ctx.context), params)
 }
 
 // GetChatAdministrators calls Client.GetChatAdministrators with context-derived defaults.
@@ -2481,7 +2563,9 @@ func (ctx *Context) GetChat(
 // [ChatMember]: https://core.telegram.org/bots/api#chatmember
 func (ctx *Context) GetChatAdministrators(
 	opts ...GetChatAdministratorsOption,
-) error {
+) ([]ChatMember, error) {
+	ctx.checkReleased()
+
 	params := &GetChatAdministratorsParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -2496,9 +2580,7 @@ func (ctx *Context) GetChatAdministrators(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetChatAdministrators(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetChatAdministrators(context.WithoutCancel(ctx.context), params)
 }
 
 // GetChatGifts calls Client.GetChatGifts with context-derived defaults.
@@ -2509,7 +2591,9 @@ func (ctx *Context) GetChatAdministrators(
 // [OwnedGifts]: https://core.telegram.org/bots/api#ownedgifts
 func (ctx *Context) GetChatGifts(
 	opts ...GetChatGiftsOption,
-) error {
+) (*OwnedGifts, error) {
+	ctx.checkReleased()
+
 	params := &GetChatGiftsParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -2524,9 +2608,7 @@ func (ctx *Context) GetChatGifts(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetChatGifts(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetChatGifts(context.WithoutCancel(ctx.context), params)
 }
 
 // GetChatMember calls Client.GetChatMember with context-derived defaults.
@@ -2538,7 +2620,9 @@ func (ctx *Context) GetChatGifts(
 // [ChatMember]: https://core.telegram.org/bots/api#chatmember
 func (ctx *Context) GetChatMember(
 	opts ...GetChatMemberOption,
-) error {
+) (*ChatMember, error) {
+	ctx.checkReleased()
+
 	params := &GetChatMemberParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -2561,9 +2645,7 @@ func (ctx *Context) GetChatMember(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetChatMember(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetChatMember(context.WithoutCancel(ctx.context), params)
 }
 
 // GetChatMemberCount calls Client.GetChatMemberCount with context-derived defaults.
@@ -2572,7 +2654,9 @@ func (ctx *Context) GetChatMember(
 // Returns Integer on success.
 func (ctx *Context) GetChatMemberCount(
 	opts ...GetChatMemberCountOption,
-) error {
+) (int64, error) {
+	ctx.checkReleased()
+
 	params := &GetChatMemberCountParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -2587,9 +2671,7 @@ func (ctx *Context) GetChatMemberCount(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetChatMemberCount(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetChatMemberCount(context.WithoutCancel(ctx.context), params)
 }
 
 // GetChatMenuButton calls Client.GetChatMenuButton with context-derived defaults.
@@ -2600,7 +2682,9 @@ func (ctx *Context) GetChatMemberCount(
 // [MenuButton]: https://core.telegram.org/bots/api#menubutton
 func (ctx *Context) GetChatMenuButton(
 	opts ...GetChatMenuButtonOption,
-) error {
+) (*MenuButton, error) {
+	ctx.checkReleased()
+
 	params := &GetChatMenuButtonParams{
 		ChatID: func(ctx *Context) int64 {
 			c := ctx.Chat()
@@ -2615,9 +2699,7 @@ func (ctx *Context) GetChatMenuButton(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetChatMenuButton(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetChatMenuButton(context.WithoutCancel(ctx.context), params)
 }
 
 // GetCustomEmojiStickers calls Client.GetCustomEmojiStickers with context-derived defaults.
@@ -2629,7 +2711,9 @@ func (ctx *Context) GetChatMenuButton(
 func (ctx *Context) GetCustomEmojiStickers(
 	customEmojiIDs []string,
 	opts ...GetCustomEmojiStickersOption,
-) error {
+) ([]Sticker, error) {
+	ctx.checkReleased()
+
 	params := &GetCustomEmojiStickersParams{
 		CustomEmojiIDs: customEmojiIDs,
 	}
@@ -2637,9 +2721,7 @@ func (ctx *Context) GetCustomEmojiStickers(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetCustomEmojiStickers(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetCustomEmojiStickers(context.WithoutCancel(ctx.context), params)
 }
 
 // GetFile calls Client.GetFile with context-derived defaults.
@@ -2658,7 +2740,9 @@ func (ctx *Context) GetCustomEmojiStickers(
 func (ctx *Context) GetFile(
 	fileID string,
 	opts ...GetFileOption,
-) error {
+) (*File, error) {
+	ctx.checkReleased()
+
 	params := &GetFileParams{
 		FileID: fileID,
 	}
@@ -2666,9 +2750,7 @@ func (ctx *Context) GetFile(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetFile(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetFile(context.WithoutCancel(ctx.context), params)
 }
 
 // GetForumTopicIconStickers calls Client.GetForumTopicIconStickers with context-derived defaults.
@@ -2680,15 +2762,15 @@ func (ctx *Context) GetFile(
 // [Sticker]: https://core.telegram.org/bots/api#sticker
 func (ctx *Context) GetForumTopicIconStickers(
 	opts ...GetForumTopicIconStickersOption,
-) error {
+) ([]Sticker, error) {
+	ctx.checkReleased()
+
 	params := &GetForumTopicIconStickersParams{}
 
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetForumTopicIconStickers(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetForumTopicIconStickers(context.WithoutCancel(ctx.context), params)
 }
 
 // GetGameHighScores calls Client.GetGameHighScores with context-derived defaults.
@@ -2700,7 +2782,9 @@ func (ctx *Context) GetForumTopicIconStickers(
 // [GameHighScore]: https://core.telegram.org/bots/api#gamehighscore
 func (ctx *Context) GetGameHighScores(
 	opts ...GetGameHighScoresOption,
-) error {
+) ([]GameHighScore, error) {
+	ctx.checkReleased()
+
 	params := &GetGameHighScoresParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -2738,9 +2822,7 @@ func (ctx *Context) GetGameHighScores(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetGameHighScores(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetGameHighScores(context.WithoutCancel(ctx.context), params)
 }
 
 // GetManagedBotAccessSettings calls Client.GetManagedBotAccessSettings with context-derived defaults.
@@ -2751,7 +2833,9 @@ func (ctx *Context) GetGameHighScores(
 // [BotAccessSettings]: https://core.telegram.org/bots/api#botaccesssettings
 func (ctx *Context) GetManagedBotAccessSettings(
 	opts ...GetManagedBotAccessSettingsOption,
-) error {
+) (*BotAccessSettings, error) {
+	ctx.checkReleased()
+
 	params := &GetManagedBotAccessSettingsParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -2766,9 +2850,7 @@ func (ctx *Context) GetManagedBotAccessSettings(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetManagedBotAccessSettings(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetManagedBotAccessSettings(context.WithoutCancel(ctx.context), params)
 }
 
 // GetManagedBotToken calls Client.GetManagedBotToken with context-derived defaults.
@@ -2777,7 +2859,9 @@ func (ctx *Context) GetManagedBotAccessSettings(
 // Returns the token as String on success.
 func (ctx *Context) GetManagedBotToken(
 	opts ...GetManagedBotTokenOption,
-) error {
+) (string, error) {
+	ctx.checkReleased()
+
 	params := &GetManagedBotTokenParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -2792,9 +2876,7 @@ func (ctx *Context) GetManagedBotToken(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetManagedBotToken(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetManagedBotToken(context.WithoutCancel(ctx.context), params)
 }
 
 // GetMe calls Client.GetMe with context-derived defaults.
@@ -2806,15 +2888,15 @@ func (ctx *Context) GetManagedBotToken(
 // [User]: https://core.telegram.org/bots/api#user
 func (ctx *Context) GetMe(
 	opts ...GetMeOption,
-) error {
+) (*User, error) {
+	ctx.checkReleased()
+
 	params := &GetMeParams{}
 
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetMe(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetMe(context.WithoutCancel(ctx.context), params)
 }
 
 // GetMyCommands calls Client.GetMyCommands with context-derived defaults.
@@ -2826,15 +2908,15 @@ func (ctx *Context) GetMe(
 // [BotCommand]: https://core.telegram.org/bots/api#botcommand
 func (ctx *Context) GetMyCommands(
 	opts ...GetMyCommandsOption,
-) error {
+) ([]BotCommand, error) {
+	ctx.checkReleased()
+
 	params := &GetMyCommandsParams{}
 
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetMyCommands(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetMyCommands(context.WithoutCancel(ctx.context), params)
 }
 
 // GetMyDefaultAdministratorRights calls Client.GetMyDefaultAdministratorRights with context-derived defaults.
@@ -2845,15 +2927,15 @@ func (ctx *Context) GetMyCommands(
 // [ChatAdministratorRights]: https://core.telegram.org/bots/api#chatadministratorrights
 func (ctx *Context) GetMyDefaultAdministratorRights(
 	opts ...GetMyDefaultAdministratorRightsOption,
-) error {
+) (*ChatAdministratorRights, error) {
+	ctx.checkReleased()
+
 	params := &GetMyDefaultAdministratorRightsParams{}
 
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetMyDefaultAdministratorRights(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetMyDefaultAdministratorRights(context.WithoutCancel(ctx.context), params)
 }
 
 // GetMyDescription calls Client.GetMyDescription with context-derived defaults.
@@ -2864,15 +2946,15 @@ func (ctx *Context) GetMyDefaultAdministratorRights(
 // [BotDescription]: https://core.telegram.org/bots/api#botdescription
 func (ctx *Context) GetMyDescription(
 	opts ...GetMyDescriptionOption,
-) error {
+) (*BotDescription, error) {
+	ctx.checkReleased()
+
 	params := &GetMyDescriptionParams{}
 
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetMyDescription(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetMyDescription(context.WithoutCancel(ctx.context), params)
 }
 
 // GetMyName calls Client.GetMyName with context-derived defaults.
@@ -2883,15 +2965,15 @@ func (ctx *Context) GetMyDescription(
 // [BotName]: https://core.telegram.org/bots/api#botname
 func (ctx *Context) GetMyName(
 	opts ...GetMyNameOption,
-) error {
+) (*BotName, error) {
+	ctx.checkReleased()
+
 	params := &GetMyNameParams{}
 
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetMyName(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetMyName(context.WithoutCancel(ctx.context), params)
 }
 
 // GetMyShortDescription calls Client.GetMyShortDescription with context-derived defaults.
@@ -2902,15 +2984,15 @@ func (ctx *Context) GetMyName(
 // [BotShortDescription]: https://core.telegram.org/bots/api#botshortdescription
 func (ctx *Context) GetMyShortDescription(
 	opts ...GetMyShortDescriptionOption,
-) error {
+) (*BotShortDescription, error) {
+	ctx.checkReleased()
+
 	params := &GetMyShortDescriptionParams{}
 
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetMyShortDescription(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetMyShortDescription(context.WithoutCancel(ctx.context), params)
 }
 
 // GetMyStarBalance calls Client.GetMyStarBalance with context-derived defaults.
@@ -2922,15 +3004,15 @@ func (ctx *Context) GetMyShortDescription(
 // [StarAmount]: https://core.telegram.org/bots/api#staramount
 func (ctx *Context) GetMyStarBalance(
 	opts ...GetMyStarBalanceOption,
-) error {
+) (*StarAmount, error) {
+	ctx.checkReleased()
+
 	params := &GetMyStarBalanceParams{}
 
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetMyStarBalance(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetMyStarBalance(context.WithoutCancel(ctx.context), params)
 }
 
 // GetStarTransactions calls Client.GetStarTransactions with context-derived defaults.
@@ -2941,15 +3023,15 @@ func (ctx *Context) GetMyStarBalance(
 // [StarTransactions]: https://core.telegram.org/bots/api#startransactions
 func (ctx *Context) GetStarTransactions(
 	opts ...GetStarTransactionsOption,
-) error {
+) (*StarTransactions, error) {
+	ctx.checkReleased()
+
 	params := &GetStarTransactionsParams{}
 
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetStarTransactions(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetStarTransactions(context.WithoutCancel(ctx.context), params)
 }
 
 // GetStickerSet calls Client.GetStickerSet with context-derived defaults.
@@ -2961,7 +3043,9 @@ func (ctx *Context) GetStarTransactions(
 func (ctx *Context) GetStickerSet(
 	name string,
 	opts ...GetStickerSetOption,
-) error {
+) (*StickerSet, error) {
+	ctx.checkReleased()
+
 	params := &GetStickerSetParams{
 		Name: name,
 	}
@@ -2969,9 +3053,7 @@ func (ctx *Context) GetStickerSet(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetStickerSet(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetStickerSet(context.WithoutCancel(ctx.context), params)
 }
 
 // GetUpdates calls Client.GetUpdates with context-derived defaults.
@@ -2983,15 +3065,15 @@ func (ctx *Context) GetStickerSet(
 // [Update]: https://core.telegram.org/bots/api#update
 func (ctx *Context) GetUpdates(
 	opts ...GetUpdatesOption,
-) error {
+) ([]Update, error) {
+	ctx.checkReleased()
+
 	params := &GetUpdatesParams{}
 
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetUpdates(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetUpdates(context.WithoutCancel(ctx.context), params)
 }
 
 // GetUserChatBoosts calls Client.GetUserChatBoosts with context-derived defaults.
@@ -3003,7 +3085,9 @@ func (ctx *Context) GetUpdates(
 // [UserChatBoosts]: https://core.telegram.org/bots/api#userchatboosts
 func (ctx *Context) GetUserChatBoosts(
 	opts ...GetUserChatBoostsOption,
-) error {
+) (*UserChatBoosts, error) {
+	ctx.checkReleased()
+
 	params := &GetUserChatBoostsParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -3026,9 +3110,7 @@ func (ctx *Context) GetUserChatBoosts(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetUserChatBoosts(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetUserChatBoosts(context.WithoutCancel(ctx.context), params)
 }
 
 // GetUserGifts calls Client.GetUserGifts with context-derived defaults.
@@ -3039,7 +3121,9 @@ func (ctx *Context) GetUserChatBoosts(
 // [OwnedGifts]: https://core.telegram.org/bots/api#ownedgifts
 func (ctx *Context) GetUserGifts(
 	opts ...GetUserGiftsOption,
-) error {
+) (*OwnedGifts, error) {
+	ctx.checkReleased()
+
 	params := &GetUserGiftsParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -3054,9 +3138,7 @@ func (ctx *Context) GetUserGifts(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetUserGifts(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetUserGifts(context.WithoutCancel(ctx.context), params)
 }
 
 // GetUserPersonalChatMessages calls Client.GetUserPersonalChatMessages with context-derived defaults.
@@ -3068,7 +3150,9 @@ func (ctx *Context) GetUserGifts(
 func (ctx *Context) GetUserPersonalChatMessages(
 	limit int64,
 	opts ...GetUserPersonalChatMessagesOption,
-) error {
+) ([]Message, error) {
+	ctx.checkReleased()
+
 	params := &GetUserPersonalChatMessagesParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -3084,9 +3168,7 @@ func (ctx *Context) GetUserPersonalChatMessages(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetUserPersonalChatMessages(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetUserPersonalChatMessages(context.WithoutCancel(ctx.context), params)
 }
 
 // GetUserProfileAudios calls Client.GetUserProfileAudios with context-derived defaults.
@@ -3097,7 +3179,9 @@ func (ctx *Context) GetUserPersonalChatMessages(
 // [UserProfileAudios]: https://core.telegram.org/bots/api#userprofileaudios
 func (ctx *Context) GetUserProfileAudios(
 	opts ...GetUserProfileAudiosOption,
-) error {
+) (*UserProfileAudios, error) {
+	ctx.checkReleased()
+
 	params := &GetUserProfileAudiosParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -3112,9 +3196,7 @@ func (ctx *Context) GetUserProfileAudios(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetUserProfileAudios(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetUserProfileAudios(context.WithoutCancel(ctx.context), params)
 }
 
 // GetUserProfilePhotos calls Client.GetUserProfilePhotos with context-derived defaults.
@@ -3125,7 +3207,9 @@ func (ctx *Context) GetUserProfileAudios(
 // [UserProfilePhotos]: https://core.telegram.org/bots/api#userprofilephotos
 func (ctx *Context) GetUserProfilePhotos(
 	opts ...GetUserProfilePhotosOption,
-) error {
+) (*UserProfilePhotos, error) {
+	ctx.checkReleased()
+
 	params := &GetUserProfilePhotosParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -3140,9 +3224,7 @@ func (ctx *Context) GetUserProfilePhotos(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetUserProfilePhotos(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetUserProfilePhotos(context.WithoutCancel(ctx.context), params)
 }
 
 // GetWebhookInfo calls Client.GetWebhookInfo with context-derived defaults.
@@ -3156,15 +3238,15 @@ func (ctx *Context) GetUserProfilePhotos(
 // [getUpdates]: https://core.telegram.org/bots/api#getupdates
 func (ctx *Context) GetWebhookInfo(
 	opts ...GetWebhookInfoOption,
-) error {
+) (*WebhookInfo, error) {
+	ctx.checkReleased()
+
 	params := &GetWebhookInfoParams{}
 
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.GetWebhookInfo(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.GetWebhookInfo(context.WithoutCancel(ctx.context), params)
 }
 
 // GiftPremiumSubscription calls Client.GiftPremiumSubscription with context-derived defaults.
@@ -3176,6 +3258,8 @@ func (ctx *Context) GiftPremiumSubscription(
 	starCount int64,
 	opts ...GiftPremiumSubscriptionOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &GiftPremiumSubscriptionParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -3211,6 +3295,8 @@ func (ctx *Context) GiftPremiumSubscription(
 func (ctx *Context) HideGeneralForumTopic(
 	opts ...HideGeneralForumTopicOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &HideGeneralForumTopicParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -3237,6 +3323,8 @@ func (ctx *Context) HideGeneralForumTopic(
 func (ctx *Context) LeaveChat(
 	opts ...LeaveChatOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &LeaveChatParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -3266,6 +3354,8 @@ func (ctx *Context) LeaveChat(
 func (ctx *Context) LogOut(
 	opts ...LogOutOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &LogOutParams{}
 
 	params.Option(opts...)
@@ -3285,6 +3375,8 @@ func (ctx *Context) LogOut(
 func (ctx *Context) PinChatMessage(
 	opts ...PinChatMessageOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &PinChatMessageParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -3331,7 +3423,9 @@ func (ctx *Context) PostStory(
 	content InputStoryContent,
 	activePeriod int64,
 	opts ...PostStoryOption,
-) error {
+) (*Story, error) {
+	ctx.checkReleased()
+
 	params := &PostStoryParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -3356,9 +3450,7 @@ func (ctx *Context) PostStory(
 	}
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.PostStory(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.PostStory(context.WithoutCancel(ctx.context), params)
 }
 
 // PromoteChatMember calls Client.PromoteChatMember with context-derived defaults.
@@ -3370,6 +3462,8 @@ func (ctx *Context) PostStory(
 func (ctx *Context) PromoteChatMember(
 	opts ...PromoteChatMemberOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &PromoteChatMemberParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -3405,6 +3499,8 @@ func (ctx *Context) PromoteChatMember(
 func (ctx *Context) ReadBusinessMessage(
 	opts ...ReadBusinessMessageOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &ReadBusinessMessageParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -3450,6 +3546,8 @@ func (ctx *Context) RefundStarPayment(
 	telegramPaymentChargeID string,
 	opts ...RefundStarPaymentOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &RefundStarPaymentParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -3478,6 +3576,8 @@ func (ctx *Context) RefundStarPayment(
 func (ctx *Context) RemoveBusinessAccountProfilePhoto(
 	opts ...RemoveBusinessAccountProfilePhotoOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &RemoveBusinessAccountProfilePhotoParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -3506,6 +3606,8 @@ func (ctx *Context) RemoveBusinessAccountProfilePhoto(
 func (ctx *Context) RemoveChatVerification(
 	opts ...RemoveChatVerificationOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &RemoveChatVerificationParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -3533,6 +3635,8 @@ func (ctx *Context) RemoveChatVerification(
 func (ctx *Context) RemoveMyProfilePhoto(
 	opts ...RemoveMyProfilePhotoOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &RemoveMyProfilePhotoParams{}
 
 	params.Option(opts...)
@@ -3552,6 +3656,8 @@ func (ctx *Context) RemoveMyProfilePhoto(
 func (ctx *Context) RemoveUserVerification(
 	opts ...RemoveUserVerificationOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &RemoveUserVerificationParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -3579,6 +3685,8 @@ func (ctx *Context) RemoveUserVerification(
 func (ctx *Context) ReopenForumTopic(
 	opts ...ReopenForumTopicOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &ReopenForumTopicParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -3615,6 +3723,8 @@ func (ctx *Context) ReopenForumTopic(
 func (ctx *Context) ReopenGeneralForumTopic(
 	opts ...ReopenGeneralForumTopicOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &ReopenGeneralForumTopicParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -3640,7 +3750,9 @@ func (ctx *Context) ReopenGeneralForumTopic(
 // Returns the new token as String on success.
 func (ctx *Context) ReplaceManagedBotToken(
 	opts ...ReplaceManagedBotTokenOption,
-) error {
+) (string, error) {
+	ctx.checkReleased()
+
 	params := &ReplaceManagedBotTokenParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -3655,9 +3767,7 @@ func (ctx *Context) ReplaceManagedBotToken(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.ReplaceManagedBotToken(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.ReplaceManagedBotToken(context.WithoutCancel(ctx.context), params)
 }
 
 // ReplaceStickerInSet calls Client.ReplaceStickerInSet with context-derived defaults.
@@ -3675,6 +3785,8 @@ func (ctx *Context) ReplaceStickerInSet(
 	sticker InputSticker,
 	opts ...ReplaceStickerInSetOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &ReplaceStickerInSetParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -3710,7 +3822,9 @@ func (ctx *Context) RepostStory(
 	fromStoryID int64,
 	activePeriod int64,
 	opts ...RepostStoryOption,
-) error {
+) (*Story, error) {
+	ctx.checkReleased()
+
 	params := &RepostStoryParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -3728,9 +3842,7 @@ func (ctx *Context) RepostStory(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.RepostStory(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.RepostStory(context.WithoutCancel(ctx.context), params)
 }
 
 // RestrictChatMember calls Client.RestrictChatMember with context-derived defaults.
@@ -3743,6 +3855,8 @@ func (ctx *Context) RestrictChatMember(
 	permissions ChatPermissions,
 	opts ...RestrictChatMemberOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &RestrictChatMemberParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -3782,7 +3896,9 @@ func (ctx *Context) RestrictChatMember(
 func (ctx *Context) RevokeChatInviteLink(
 	inviteLink string,
 	opts ...RevokeChatInviteLinkOption,
-) error {
+) (*ChatInviteLink, error) {
+	ctx.checkReleased()
+
 	params := &RevokeChatInviteLinkParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -3798,9 +3914,7 @@ func (ctx *Context) RevokeChatInviteLink(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.RevokeChatInviteLink(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.RevokeChatInviteLink(context.WithoutCancel(ctx.context), params)
 }
 
 // SavePreparedInlineMessage calls Client.SavePreparedInlineMessage with context-derived defaults.
@@ -3812,7 +3926,9 @@ func (ctx *Context) RevokeChatInviteLink(
 func (ctx *Context) SavePreparedInlineMessage(
 	result InlineQueryResult,
 	opts ...SavePreparedInlineMessageOption,
-) error {
+) (*PreparedInlineMessage, error) {
+	ctx.checkReleased()
+
 	params := &SavePreparedInlineMessageParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -3828,9 +3944,7 @@ func (ctx *Context) SavePreparedInlineMessage(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SavePreparedInlineMessage(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SavePreparedInlineMessage(context.WithoutCancel(ctx.context), params)
 }
 
 // SavePreparedKeyboardButton calls Client.SavePreparedKeyboardButton with context-derived defaults.
@@ -3842,7 +3956,9 @@ func (ctx *Context) SavePreparedInlineMessage(
 func (ctx *Context) SavePreparedKeyboardButton(
 	button KeyboardButton,
 	opts ...SavePreparedKeyboardButtonOption,
-) error {
+) (*PreparedKeyboardButton, error) {
+	ctx.checkReleased()
+
 	params := &SavePreparedKeyboardButtonParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -3858,9 +3974,7 @@ func (ctx *Context) SavePreparedKeyboardButton(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SavePreparedKeyboardButton(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SavePreparedKeyboardButton(context.WithoutCancel(ctx.context), params)
 }
 
 // SendAnimation calls Client.SendAnimation with context-derived defaults.
@@ -3873,7 +3987,9 @@ func (ctx *Context) SavePreparedKeyboardButton(
 func (ctx *Context) SendAnimation(
 	animation InputFile,
 	opts ...SendAnimationOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendAnimationParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -3936,9 +4052,7 @@ func (ctx *Context) SendAnimation(
 	}
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendAnimation(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendAnimation(context.WithoutCancel(ctx.context), params)
 }
 
 // SendAudio calls Client.SendAudio with context-derived defaults.
@@ -3954,7 +4068,9 @@ func (ctx *Context) SendAnimation(
 func (ctx *Context) SendAudio(
 	audio InputFile,
 	opts ...SendAudioOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendAudioParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -4017,9 +4133,7 @@ func (ctx *Context) SendAudio(
 	}
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendAudio(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendAudio(context.WithoutCancel(ctx.context), params)
 }
 
 // SendChatAction calls Client.SendChatAction with context-derived defaults.
@@ -4032,6 +4146,8 @@ func (ctx *Context) SendChatAction(
 	action string,
 	opts ...SendChatActionOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SendChatActionParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -4080,6 +4196,8 @@ func (ctx *Context) SendChatJoinRequestWebApp(
 	webAppUrl string,
 	opts ...SendChatJoinRequestWebAppOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SendChatJoinRequestWebAppParams{
 		ChatJoinRequestQueryID: chatJoinRequestQueryID,
 		WebAppUrl:              webAppUrl,
@@ -4102,7 +4220,9 @@ func (ctx *Context) SendChatJoinRequestWebApp(
 func (ctx *Context) SendChecklist(
 	checklist InputChecklist,
 	opts ...SendChecklistOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendChecklistParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -4126,9 +4246,7 @@ func (ctx *Context) SendChecklist(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendChecklist(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendChecklist(context.WithoutCancel(ctx.context), params)
 }
 
 // SendContact calls Client.SendContact with context-derived defaults.
@@ -4141,7 +4259,9 @@ func (ctx *Context) SendContact(
 	phoneNumber string,
 	firstName string,
 	opts ...SendContactOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendContactParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -4197,9 +4317,7 @@ func (ctx *Context) SendContact(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendContact(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendContact(context.WithoutCancel(ctx.context), params)
 }
 
 // SendDice calls Client.SendDice with context-derived defaults.
@@ -4210,7 +4328,9 @@ func (ctx *Context) SendContact(
 // [Message]: https://core.telegram.org/bots/api#message
 func (ctx *Context) SendDice(
 	opts ...SendDiceOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendDiceParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -4253,9 +4373,7 @@ func (ctx *Context) SendDice(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendDice(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendDice(context.WithoutCancel(ctx.context), params)
 }
 
 // SendDocument calls Client.SendDocument with context-derived defaults.
@@ -4268,7 +4386,9 @@ func (ctx *Context) SendDice(
 func (ctx *Context) SendDocument(
 	document InputFile,
 	opts ...SendDocumentOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendDocumentParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -4331,9 +4451,7 @@ func (ctx *Context) SendDocument(
 	}
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendDocument(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendDocument(context.WithoutCancel(ctx.context), params)
 }
 
 // SendGame calls Client.SendGame with context-derived defaults.
@@ -4345,7 +4463,9 @@ func (ctx *Context) SendDocument(
 func (ctx *Context) SendGame(
 	gameShortName string,
 	opts ...SendGameOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendGameParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -4377,9 +4497,7 @@ func (ctx *Context) SendGame(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendGame(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendGame(context.WithoutCancel(ctx.context), params)
 }
 
 // SendGift calls Client.SendGift with context-derived defaults.
@@ -4391,6 +4509,8 @@ func (ctx *Context) SendGift(
 	giftID string,
 	opts ...SendGiftOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SendGiftParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -4437,7 +4557,9 @@ func (ctx *Context) SendInvoice(
 	currency string,
 	prices []LabeledPrice,
 	opts ...SendInvoiceOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendInvoiceParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -4477,9 +4599,7 @@ func (ctx *Context) SendInvoice(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendInvoice(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendInvoice(context.WithoutCancel(ctx.context), params)
 }
 
 // SendLivePhoto calls Client.SendLivePhoto with context-derived defaults.
@@ -4492,7 +4612,9 @@ func (ctx *Context) SendLivePhoto(
 	livePhoto InputFile,
 	photo InputFile,
 	opts ...SendLivePhotoOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendLivePhotoParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -4556,9 +4678,7 @@ func (ctx *Context) SendLivePhoto(
 	}
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendLivePhoto(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendLivePhoto(context.WithoutCancel(ctx.context), params)
 }
 
 // SendLocation calls Client.SendLocation with context-derived defaults.
@@ -4571,7 +4691,9 @@ func (ctx *Context) SendLocation(
 	latitude float64,
 	longitude float64,
 	opts ...SendLocationOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendLocationParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -4627,9 +4749,7 @@ func (ctx *Context) SendLocation(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendLocation(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendLocation(context.WithoutCancel(ctx.context), params)
 }
 
 // SendMediaGroup calls Client.SendMediaGroup with context-derived defaults.
@@ -4642,7 +4762,9 @@ func (ctx *Context) SendLocation(
 func (ctx *Context) SendMediaGroup(
 	media []InputMedia,
 	opts ...SendMediaGroupOption,
-) error {
+) ([]Message, error) {
+	ctx.checkReleased()
+
 	params := &SendMediaGroupParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -4694,9 +4816,7 @@ func (ctx *Context) SendMediaGroup(
 	}
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendMediaGroup(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendMediaGroup(context.WithoutCancel(ctx.context), params)
 }
 
 // SendMessage calls Client.SendMessage with context-derived defaults.
@@ -4708,7 +4828,9 @@ func (ctx *Context) SendMediaGroup(
 func (ctx *Context) SendMessage(
 	text string,
 	opts ...SendMessageOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendMessageParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -4771,9 +4893,7 @@ func (ctx *Context) SendMessage(
 	}
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendMessage(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendMessage(context.WithoutCancel(ctx.context), params)
 }
 
 // SendMessageDraft calls Client.SendMessageDraft with context-derived defaults.
@@ -4787,6 +4907,8 @@ func (ctx *Context) SendMessageDraft(
 	draftID int64,
 	opts ...SendMessageDraftOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SendMessageDraftParams{
 		ChatID: func(ctx *Context) int64 {
 			c := ctx.Chat()
@@ -4833,7 +4955,9 @@ func (ctx *Context) SendPaidMedia(
 	starCount int64,
 	media []InputPaidMedia,
 	opts ...SendPaidMediaOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendPaidMediaParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -4886,9 +5010,7 @@ func (ctx *Context) SendPaidMedia(
 	}
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendPaidMedia(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendPaidMedia(context.WithoutCancel(ctx.context), params)
 }
 
 // SendPhoto calls Client.SendPhoto with context-derived defaults.
@@ -4900,7 +5022,9 @@ func (ctx *Context) SendPaidMedia(
 func (ctx *Context) SendPhoto(
 	photo InputFile,
 	opts ...SendPhotoOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendPhotoParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -4963,9 +5087,7 @@ func (ctx *Context) SendPhoto(
 	}
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendPhoto(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendPhoto(context.WithoutCancel(ctx.context), params)
 }
 
 // SendPoll calls Client.SendPoll with context-derived defaults.
@@ -4978,7 +5100,9 @@ func (ctx *Context) SendPoll(
 	question string,
 	options []InputPollOption,
 	opts ...SendPollOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendPollParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -5016,9 +5140,7 @@ func (ctx *Context) SendPoll(
 	}
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendPoll(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendPoll(context.WithoutCancel(ctx.context), params)
 }
 
 // SendRichMessage calls Client.SendRichMessage with context-derived defaults.
@@ -5031,7 +5153,9 @@ func (ctx *Context) SendPoll(
 func (ctx *Context) SendRichMessage(
 	richMessage InputRichMessage,
 	opts ...SendRichMessageOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendRichMessageParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -5075,9 +5199,7 @@ func (ctx *Context) SendRichMessage(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendRichMessage(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendRichMessage(context.WithoutCancel(ctx.context), params)
 }
 
 // SendRichMessageDraft calls Client.SendRichMessageDraft with context-derived defaults.
@@ -5092,6 +5214,8 @@ func (ctx *Context) SendRichMessageDraft(
 	richMessage InputRichMessage,
 	opts ...SendRichMessageDraftOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SendRichMessageDraftParams{
 		ChatID: func(ctx *Context) int64 {
 			c := ctx.Chat()
@@ -5132,7 +5256,9 @@ func (ctx *Context) SendRichMessageDraft(
 func (ctx *Context) SendSticker(
 	sticker InputFile,
 	opts ...SendStickerOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendStickerParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -5187,9 +5313,7 @@ func (ctx *Context) SendSticker(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendSticker(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendSticker(context.WithoutCancel(ctx.context), params)
 }
 
 // SendVenue calls Client.SendVenue with context-derived defaults.
@@ -5204,7 +5328,9 @@ func (ctx *Context) SendVenue(
 	title string,
 	address string,
 	opts ...SendVenueOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendVenueParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -5262,9 +5388,7 @@ func (ctx *Context) SendVenue(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendVenue(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendVenue(context.WithoutCancel(ctx.context), params)
 }
 
 // SendVideo calls Client.SendVideo with context-derived defaults.
@@ -5278,7 +5402,9 @@ func (ctx *Context) SendVenue(
 func (ctx *Context) SendVideo(
 	video InputFile,
 	opts ...SendVideoOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendVideoParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -5341,9 +5467,7 @@ func (ctx *Context) SendVideo(
 	}
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendVideo(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendVideo(context.WithoutCancel(ctx.context), params)
 }
 
 // SendVideoNote calls Client.SendVideoNote with context-derived defaults.
@@ -5357,7 +5481,9 @@ func (ctx *Context) SendVideo(
 func (ctx *Context) SendVideoNote(
 	videoNote InputFile,
 	opts ...SendVideoNoteOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendVideoNoteParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -5412,9 +5538,7 @@ func (ctx *Context) SendVideoNote(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendVideoNote(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendVideoNote(context.WithoutCancel(ctx.context), params)
 }
 
 // SendVoice calls Client.SendVoice with context-derived defaults.
@@ -5430,7 +5554,9 @@ func (ctx *Context) SendVideoNote(
 func (ctx *Context) SendVoice(
 	voice InputFile,
 	opts ...SendVoiceOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SendVoiceParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -5493,9 +5619,7 @@ func (ctx *Context) SendVoice(
 	}
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SendVoice(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SendVoice(context.WithoutCancel(ctx.context), params)
 }
 
 // SetBusinessAccountBio calls Client.SetBusinessAccountBio with context-derived defaults.
@@ -5506,6 +5630,8 @@ func (ctx *Context) SendVoice(
 func (ctx *Context) SetBusinessAccountBio(
 	opts ...SetBusinessAccountBioOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetBusinessAccountBioParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -5535,6 +5661,8 @@ func (ctx *Context) SetBusinessAccountGiftSettings(
 	acceptedGiftTypes AcceptedGiftTypes,
 	opts ...SetBusinessAccountGiftSettingsOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetBusinessAccountGiftSettingsParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -5565,6 +5693,8 @@ func (ctx *Context) SetBusinessAccountName(
 	firstName string,
 	opts ...SetBusinessAccountNameOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetBusinessAccountNameParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -5594,6 +5724,8 @@ func (ctx *Context) SetBusinessAccountProfilePhoto(
 	photo InputProfilePhoto,
 	opts ...SetBusinessAccountProfilePhotoOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetBusinessAccountProfilePhotoParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -5622,6 +5754,8 @@ func (ctx *Context) SetBusinessAccountProfilePhoto(
 func (ctx *Context) SetBusinessAccountUsername(
 	opts ...SetBusinessAccountUsernameOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetBusinessAccountUsernameParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -5649,6 +5783,8 @@ func (ctx *Context) SetChatAdministratorCustomTitle(
 	customTitle string,
 	opts ...SetChatAdministratorCustomTitleOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetChatAdministratorCustomTitleParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -5685,6 +5821,8 @@ func (ctx *Context) SetChatAdministratorCustomTitle(
 func (ctx *Context) SetChatDescription(
 	opts ...SetChatDescriptionOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetChatDescriptionParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -5712,6 +5850,8 @@ func (ctx *Context) SetChatDescription(
 func (ctx *Context) SetChatMemberTag(
 	opts ...SetChatMemberTagOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetChatMemberTagParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -5746,6 +5886,8 @@ func (ctx *Context) SetChatMemberTag(
 func (ctx *Context) SetChatMenuButton(
 	opts ...SetChatMenuButtonOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetChatMenuButtonParams{
 		ChatID: func(ctx *Context) int64 {
 			c := ctx.Chat()
@@ -5774,6 +5916,8 @@ func (ctx *Context) SetChatPermissions(
 	permissions ChatPermissions,
 	opts ...SetChatPermissionsOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetChatPermissionsParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -5804,6 +5948,8 @@ func (ctx *Context) SetChatPhoto(
 	photo InputFile,
 	opts ...SetChatPhotoOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetChatPhotoParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -5836,6 +5982,8 @@ func (ctx *Context) SetChatStickerSet(
 	stickerSetName string,
 	opts ...SetChatStickerSetOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetChatStickerSetParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -5866,6 +6014,8 @@ func (ctx *Context) SetChatTitle(
 	title string,
 	opts ...SetChatTitleOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetChatTitleParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -5894,6 +6044,8 @@ func (ctx *Context) SetCustomEmojiStickerSetThumbnail(
 	name string,
 	opts ...SetCustomEmojiStickerSetThumbnailOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetCustomEmojiStickerSetThumbnailParams{
 		Name: name,
 	}
@@ -5916,7 +6068,9 @@ func (ctx *Context) SetCustomEmojiStickerSetThumbnail(
 func (ctx *Context) SetGameScore(
 	score int64,
 	opts ...SetGameScoreOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &SetGameScoreParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -5955,9 +6109,7 @@ func (ctx *Context) SetGameScore(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.SetGameScore(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.SetGameScore(context.WithoutCancel(ctx.context), params)
 }
 
 // SetManagedBotAccessSettings calls Client.SetManagedBotAccessSettings with context-derived defaults.
@@ -5968,6 +6120,8 @@ func (ctx *Context) SetManagedBotAccessSettings(
 	isAccessRestricted bool,
 	opts ...SetManagedBotAccessSettingsOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetManagedBotAccessSettingsParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -5998,6 +6152,8 @@ func (ctx *Context) SetManagedBotAccessSettings(
 func (ctx *Context) SetMessageReaction(
 	opts ...SetMessageReactionOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetMessageReactionParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -6036,6 +6192,8 @@ func (ctx *Context) SetMyCommands(
 	commands []BotCommand,
 	opts ...SetMyCommandsOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetMyCommandsParams{
 		Commands: commands,
 	}
@@ -6056,6 +6214,8 @@ func (ctx *Context) SetMyCommands(
 func (ctx *Context) SetMyDefaultAdministratorRights(
 	opts ...SetMyDefaultAdministratorRightsOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetMyDefaultAdministratorRightsParams{}
 
 	params.Option(opts...)
@@ -6073,6 +6233,8 @@ func (ctx *Context) SetMyDefaultAdministratorRights(
 func (ctx *Context) SetMyDescription(
 	opts ...SetMyDescriptionOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetMyDescriptionParams{}
 
 	params.Option(opts...)
@@ -6090,6 +6252,8 @@ func (ctx *Context) SetMyDescription(
 func (ctx *Context) SetMyName(
 	opts ...SetMyNameOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetMyNameParams{}
 
 	params.Option(opts...)
@@ -6108,6 +6272,8 @@ func (ctx *Context) SetMyProfilePhoto(
 	photo InputProfilePhoto,
 	opts ...SetMyProfilePhotoOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetMyProfilePhotoParams{
 		Photo: photo,
 	}
@@ -6127,6 +6293,8 @@ func (ctx *Context) SetMyProfilePhoto(
 func (ctx *Context) SetMyShortDescription(
 	opts ...SetMyShortDescriptionOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetMyShortDescriptionParams{}
 
 	params.Option(opts...)
@@ -6149,6 +6317,8 @@ func (ctx *Context) SetPassportDataErrors(
 	errors []PassportElementError,
 	opts ...SetPassportDataErrorsOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetPassportDataErrorsParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -6179,6 +6349,8 @@ func (ctx *Context) SetStickerEmojiList(
 	emojiList []string,
 	opts ...SetStickerEmojiListOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetStickerEmojiListParams{
 		Sticker:   sticker,
 		EmojiList: emojiList,
@@ -6201,6 +6373,8 @@ func (ctx *Context) SetStickerKeywords(
 	sticker string,
 	opts ...SetStickerKeywordsOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetStickerKeywordsParams{
 		Sticker: sticker,
 	}
@@ -6224,6 +6398,8 @@ func (ctx *Context) SetStickerMaskPosition(
 	sticker string,
 	opts ...SetStickerMaskPositionOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetStickerMaskPositionParams{
 		Sticker: sticker,
 	}
@@ -6245,6 +6421,8 @@ func (ctx *Context) SetStickerPositionInSet(
 	position int64,
 	opts ...SetStickerPositionInSetOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetStickerPositionInSetParams{
 		Sticker:  sticker,
 		Position: position,
@@ -6268,6 +6446,8 @@ func (ctx *Context) SetStickerSetThumbnail(
 	format string,
 	opts ...SetStickerSetThumbnailOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetStickerSetThumbnailParams{
 		Name: name,
 		UserID: func(ctx *Context) int64 {
@@ -6298,6 +6478,8 @@ func (ctx *Context) SetStickerSetTitle(
 	title string,
 	opts ...SetStickerSetTitleOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetStickerSetTitleParams{
 		Name:  name,
 		Title: title,
@@ -6320,6 +6502,8 @@ func (ctx *Context) SetStickerSetTitle(
 func (ctx *Context) SetUserEmojiStatus(
 	opts ...SetUserEmojiStatusOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetUserEmojiStatusParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -6354,6 +6538,8 @@ func (ctx *Context) SetWebhook(
 	uRL string,
 	opts ...SetWebhookOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &SetWebhookParams{
 		URL: uRL,
 	}
@@ -6374,7 +6560,9 @@ func (ctx *Context) SetWebhook(
 // [Message]: https://core.telegram.org/bots/api#message
 func (ctx *Context) StopMessageLiveLocation(
 	opts ...StopMessageLiveLocationOption,
-) error {
+) (*Message, error) {
+	ctx.checkReleased()
+
 	params := &StopMessageLiveLocationParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -6412,9 +6600,7 @@ func (ctx *Context) StopMessageLiveLocation(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.StopMessageLiveLocation(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.StopMessageLiveLocation(context.WithoutCancel(ctx.context), params)
 }
 
 // StopPoll calls Client.StopPoll with context-derived defaults.
@@ -6425,7 +6611,9 @@ func (ctx *Context) StopMessageLiveLocation(
 // [Poll]: https://core.telegram.org/bots/api#poll
 func (ctx *Context) StopPoll(
 	opts ...StopPollOption,
-) error {
+) (*Poll, error) {
+	ctx.checkReleased()
+
 	params := &StopPollParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -6456,9 +6644,7 @@ func (ctx *Context) StopPoll(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.StopPoll(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.StopPoll(context.WithoutCancel(ctx.context), params)
 }
 
 // TransferBusinessAccountStars calls Client.TransferBusinessAccountStars with context-derived defaults.
@@ -6470,6 +6656,8 @@ func (ctx *Context) TransferBusinessAccountStars(
 	starCount int64,
 	opts ...TransferBusinessAccountStarsOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &TransferBusinessAccountStarsParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -6501,6 +6689,8 @@ func (ctx *Context) TransferGift(
 	newOwnerChatID int64,
 	opts ...TransferGiftOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &TransferGiftParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -6534,6 +6724,8 @@ func (ctx *Context) TransferGift(
 func (ctx *Context) UnbanChatMember(
 	opts ...UnbanChatMemberOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &UnbanChatMemberParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -6570,6 +6762,8 @@ func (ctx *Context) UnbanChatSenderChat(
 	senderChatID int64,
 	opts ...UnbanChatSenderChatOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &UnbanChatSenderChatParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -6598,6 +6792,8 @@ func (ctx *Context) UnbanChatSenderChat(
 func (ctx *Context) UnhideGeneralForumTopic(
 	opts ...UnhideGeneralForumTopicOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &UnhideGeneralForumTopicParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -6626,6 +6822,8 @@ func (ctx *Context) UnhideGeneralForumTopic(
 func (ctx *Context) UnpinAllChatMessages(
 	opts ...UnpinAllChatMessagesOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &UnpinAllChatMessagesParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -6653,6 +6851,8 @@ func (ctx *Context) UnpinAllChatMessages(
 func (ctx *Context) UnpinAllForumTopicMessages(
 	opts ...UnpinAllForumTopicMessagesOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &UnpinAllForumTopicMessagesParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -6688,6 +6888,8 @@ func (ctx *Context) UnpinAllForumTopicMessages(
 func (ctx *Context) UnpinAllGeneralForumTopicMessages(
 	opts ...UnpinAllGeneralForumTopicMessagesOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &UnpinAllGeneralForumTopicMessagesParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -6716,6 +6918,8 @@ func (ctx *Context) UnpinAllGeneralForumTopicMessages(
 func (ctx *Context) UnpinChatMessage(
 	opts ...UnpinChatMessageOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &UnpinChatMessageParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -6761,6 +6965,8 @@ func (ctx *Context) UpgradeGift(
 	ownedGiftID string,
 	opts ...UpgradeGiftOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &UpgradeGiftParams{
 		BusinessConnectionID: func(ctx *Context) string {
 			m := ctx.Message()
@@ -6794,7 +7000,9 @@ func (ctx *Context) UploadStickerFile(
 	sticker InputFile,
 	stickerFormat string,
 	opts ...UploadStickerFileOption,
-) error {
+) (*File, error) {
+	ctx.checkReleased()
+
 	params := &UploadStickerFileParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -6811,9 +7019,7 @@ func (ctx *Context) UploadStickerFile(
 	params.Option(opts...)
 
 	// Detach cancellation while preserving context values for worker-side requests.
-	_, err := ctx.client.UploadStickerFile(context.WithoutCancel(ctx.context), params)
-
-	return err
+	return ctx.client.UploadStickerFile(context.WithoutCancel(ctx.context), params)
 }
 
 // VerifyChat calls Client.VerifyChat with context-derived defaults.
@@ -6825,6 +7031,8 @@ func (ctx *Context) UploadStickerFile(
 func (ctx *Context) VerifyChat(
 	opts ...VerifyChatOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &VerifyChatParams{
 		ChatID: func(ctx *Context) string {
 			c := ctx.Chat()
@@ -6853,6 +7061,8 @@ func (ctx *Context) VerifyChat(
 func (ctx *Context) VerifyUser(
 	opts ...VerifyUserOption,
 ) error {
+	ctx.checkReleased()
+
 	params := &VerifyUserParams{
 		UserID: func(ctx *Context) int64 {
 			u := ctx.User()
@@ -2,13 +2,14 @@ package gogram
 
 import (
 	"context"
+	"maps"
 	"sync"
 	"time"
 )
 
 var contextPool = sync.Pool{
 	New: func() any {
-		return &Context{}
+		return &Context{pooled: true}
 	},
 }
 
@@ -17,19 +18,78 @@ func NewTestContext(ctx context.Context, c *Client, u *Update) *Context {
 	return c.acquireContext(ctx, u)
 }
 
+// ContextForOptions selects the topic or business connection targeted by a
+// Context created with [Client.ContextFor].
+type ContextForOptions struct {
+	// MessageThreadID is the forum topic of the chat.
+	MessageThreadID int64
+	// DirectMessagesTopicID is the topic of a channel direct messages chat.
+	DirectMessagesTopicID int64
+	// BusinessConnectionID is the business connection to act on behalf of.
+	BusinessConnectionID string
+}
+
+// ContextFor creates a Context targeting chat outside of update handling,
+// e.g. for scheduled jobs and broadcasts. Its helpers fill chat_id,
+// message_thread_id, direct_messages_topic_id and business_connection_id
+// from chat and opts, which may be nil, as they do from an update:
+//
+//	bot := client.ContextFor(ctx, gogram.Chat{ID: chatID}, nil)
+//	msg, err := bot.SendMessage("Daily report")
+//
+// Update returns a synthesized update holding a message with these
+// identifiers only. The Context is not pooled and may be kept indefinitely.
+func (c *Client) ContextFor(ctx context.Context, chat Chat, opts *ContextForOptions) *Context {
+	message := &Message{Chat: chat}
+
+	if opts != nil {
+		message.MessageThreadID = opts.MessageThreadID
+		message.BusinessConnectionID = opts.BusinessConnectionID
+
+		if opts.DirectMessagesTopicID != 0 {
+			message.DirectMessagesTopic = &DirectMessagesTopic{TopicID: opts.DirectMessagesTopicID}
+		}
+	}
+
+	update := &Update{Message: message}
+	if message.BusinessConnectionID != "" {
+		update = &Update{BusinessMessage: message}
+	}
+
+	return &Context{
+		context: ctx,
+		client:  c,
+		update:  update,
+	}
+}
+
 func (c *Client) acquireContext(ctx context.Context, update *Update) *Context {
 	v := contextPool.Get().(*Context)
 	v.context = ctx
 	v.client = c
 	v.update = update
+	v.released = false
 	return v
 }
 
 func (c *Client) releaseContext(ctx *Context) {
+	if !ctx.pooled {
+		return
+	}
+
+	ctx.released = true
+	if debugContext {
+		// Keep released contexts out of the pool so that a late use
+		// is detected instead of reading another update.
+		return
+	}
+
 	ctx.context = nil
 	ctx.client = nil
 	ctx.update = nil
 	ctx.values = nil
+	ctx.overloaded = false
+	ctx.handlerErr = nil
 	contextPool.Put(ctx)
 }
 
@@ -39,27 +99,81 @@ type Context struct {
 	client  *Client
 	update  *Update
 	values  map[any]any
+
+	overloaded bool
+	handlerErr error
+
+	pooled   bool
+	released bool
+}
+
+// checkReleased panics in debug builds if ctx is used after its handler returned.
+func (ctx *Context) checkReleased() {
+	if debugContext && ctx.released {
+		panic("gogram: Context used after its handler returned; use Context.Detach or Context.Clone to keep it in another goroutine")
+	}
+}
+
+// Clone returns a copy of ctx that remains valid after the handler returns,
+// so it can be passed to goroutines. The copy shares the underlying
+// context.Context, so it is still cancelled with the update processing.
+// Values set on the copy are not visible in ctx and vice versa.
+func (ctx *Context) Clone() *Context {
+	ctx.checkReleased()
+
+	return &Context{
+		context: ctx.context,
+		client:  ctx.client,
+		update:  ctx.update,
+		values:  maps.Clone(ctx.values),
+	}
+}
+
+// Detach is like [Context.Clone], but the copy is not cancelled when the
+// update processing ends or the webhook request is closed. Use it to start
+// background work from a handler:
+//
+//	detached := ctx.Detach()
+//	go func() {
+//		_, _ = detached.SendMessage(report())
+//	}()
+//
+// Its deadline and cancellation can be set with [context.WithTimeout] and
+// passed to [Client] methods as usual.
+func (ctx *Context) Detach() *Context {
+	detached := ctx.Clone()
+	detached.context = context.WithoutCancel(detached.context)
+
+	return detached
 }
 
 // Deadline returns the time when work done on behalf of this context
 // should be cancelled.
 func (ctx *Context) Deadline() (deadline time.Time, ok bool) {
+	ctx.checkReleased()
+
 	return ctx.context.Deadline()
 }
 
 // Done returns a channel that's closed when work done on behalf of this
 // context should be cancelled.
 func (ctx *Context) Done() <-chan struct{} {
+	ctx.checkReleased()
+
 	return ctx.context.Done()
 }
 
 // Err returns a non-nil error value after Done is closed.
 func (ctx *Context) Err() error {
+	ctx.checkReleased()
+
 	return ctx.context.Err()
 }
 
 // SetValue sets a value in the context.
 func (ctx *Context) SetValue(key, value any) {
+	ctx.checkReleased()
+
 	if ctx.values == nil {
 		ctx.values = make(map[any]any, 1)
 	}
@@ -69,6 +183,8 @@ func (ctx *Context) SetValue(key, value any) {
 // Value returns the value associated with this context for key, or nil
 // if no value is associated with key.
 func (ctx *Context) Value(key any) any {
+	ctx.checkReleased()
+
 	if value, ok := ctx.values[key]; ok {
 		return value
 	}
@@ -78,15 +194,55 @@ func (ctx *Context) Value(key any) any {
 
 // Client returns the client that created this context.
 func (ctx *Context) Client() *Client {
+	ctx.checkReleased()
+
 	return ctx.client
 }
 
 // Update returns the update that triggered this context.
 func (ctx *Context) Update() *Update {
+	ctx.checkReleased()
+
 	return ctx.update
 }
 
+// Overloaded reports whether the update was shed by the dispatcher
+// because its queue was full.
+func (ctx *Context) Overloaded() bool {
+	ctx.checkReleased()
+
+	return ctx.overloaded
+}
+
 // Context returns the underlying context.Context.
 func (ctx *Context) Context() context.Context {
+	ctx.checkReleased()
+
 	return ctx.context
 }
+
+// WebhookReply returns a copy of ctx whose next API call is returned as the
+// body of the webhook HTTP response instead of a separate request, saving a
+// round-trip:
+//
+//	return ctx.WebhookReply().AnswerCallbackQuery()
+//
+// Only that call can be claimed: later calls made with the copy and calls
+// made with ctx itself are sent to the Bot API as usual. The result of the
+// claimed call is unknown, so it returns a nil result and a nil error.
+// Only JSON requests are eligible; file uploads, calls made under long polling
+// and calls made after the handler has returned are sent to the Bot API as
+// usual. Without a webhook request WebhookReply returns ctx.
+func (ctx *Context) WebhookReply() *Context {
+	ctx.checkReleased()
+
+	reply, ok := ctx.context.Value(webhookReplyContextKey).(*webhookReply)
+	if !ok {
+		return ctx
+	}
+
+	scoped := ctx.Clone()
+	scoped.context = context.WithValue(scoped.context, webhookReplyScopeContextKey, &webhookReplyScope{reply: reply})
+
+	return scoped
+}
@@ -0,0 +1,51 @@
+//go:build gogramdebug
+
+package gogram_test
+
+import (
+	"testing"
+
+	"github.com/darxnet/gogram"
+)
+
+func TestContext_UseAfterReleasePanics(t *testing.T) {
+	t.Parallel()
+
+	var leaked *gogram.Context
+	router := gogram.NewRouter()
+	router.HandleOnMessage(func(ctx *gogram.Context, _ *gogram.Message) error {
+		leaked = ctx
+		return nil
+	})
+
+	client, err := gogram.NewClient(testToken, gogram.WithRouter(router))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	deliveries := make(chan gogram.Delivery, 1)
+	deliveries <- gogram.Delivery{Update: &gogram.Update{Message: &gogram.Message{}}}
+	close(deliveries)
+
+	if err = client.Run(t.Context(), gogram.ChannelSource(deliveries)); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	for name, use := range map[string]func(*gogram.Context){
+		"Update":     func(ctx *gogram.Context) { _ = ctx.Update() },
+		"User":       func(ctx *gogram.Context) { _ = ctx.User() },
+		"Chat":       func(ctx *gogram.Context) { _ = ctx.Chat() },
+		"Message":    func(ctx *gogram.Context) { _ = ctx.Message() },
+		"Overloaded": func(ctx *gogram.Context) { _ = ctx.Overloaded() },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected a panic on use after release", name)
+				}
+			}()
+
+			use(leaked)
+		}()
+	}
+}
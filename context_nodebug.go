@@ -0,0 +1,7 @@
+//go:build !gogramdebug
+
+package gogram
+
+// debugContext enables detection of [Context] use after its handler
+// returned. Build with -tags gogramdebug to turn it on.
+const debugContext = false
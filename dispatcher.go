@@ -0,0 +1,344 @@
+package gogram
+
+import (
+	"maps"
+	"slices"
+	"sync"
+)
+
+// pendingPerWorker bounds how many accepted updates may wait for a free
+// worker by default, relative to the dispatcher concurrency.
+const pendingPerWorker = 4
+
+// Dispatcher schedules update processing for polling and webhook runs.
+type Dispatcher interface {
+	// Dispatch schedules process(ctx). It may block while the dispatcher is
+	// full and returns ctx.Err() if ctx is done before the update is accepted.
+	// The dispatcher owns ctx once Dispatch returns nil and must eventually
+	// call process with it, also for updates it sheds.
+	Dispatch(ctx *Context, process func(*Context)) error
+	// Wait blocks until every accepted update has been processed.
+	Wait()
+}
+
+// WithDispatcher sets the update dispatcher shared by polling and webhook runs.
+// By default each run uses a [QueueDispatcher] keyed by [DispatchByChat]
+// with the concurrency set by [WithNumWorkers].
+func WithDispatcher(dispatcher Dispatcher) ClientOption {
+	return func(c *Client) {
+		c.cfg.dispatcher = dispatcher
+	}
+}
+
+// DispatchKeyFunc returns the key whose updates must be processed in order.
+// Updates for which ok is false are processed without ordering.
+type DispatchKeyFunc func(ctx *Context) (key int64, ok bool)
+
+// DispatchByChat orders updates within the same chat.
+func DispatchByChat(ctx *Context) (int64, bool) {
+	if chat := ctx.Chat(); chat != nil {
+		return chat.ID, true
+	}
+
+	return 0, false
+}
+
+// DispatchByUser orders updates from the same user.
+func DispatchByUser(ctx *Context) (int64, bool) {
+	if user := ctx.User(); user != nil {
+		return user.ID, true
+	}
+
+	return 0, false
+}
+
+// OverflowPolicy decides what a [QueueDispatcher] does when its queue is full.
+type OverflowPolicy int
+
+// Overflow policies.
+const (
+	// OverflowBlock makes Dispatch wait for free space, slowing down polling.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropOldest sheds the oldest queued update to make room.
+	OverflowDropOldest
+	// OverflowDropNewest sheds the incoming update.
+	OverflowDropNewest
+)
+
+// QueueDispatcherOption configures a QueueDispatcher.
+type QueueDispatcherOption func(d *QueueDispatcher)
+
+// WithQueueLimit sets how many accepted updates may wait for a worker.
+// By default it is four times the concurrency.
+func WithQueueLimit(limit int) QueueDispatcherOption {
+	return func(d *QueueDispatcher) {
+		d.limit = max(limit, 1)
+	}
+}
+
+// WithKeyQueueLimit sets how many updates sharing a key may be queued or
+// running, so that a busy key cannot fill the whole queue and hold up other
+// keys. The policy set by [WithOverflowPolicy] applies when it is reached,
+// dropping the oldest update of the key for [OverflowDropOldest].
+// By default only the limit set by [WithQueueLimit] applies.
+func WithKeyQueueLimit(limit int) QueueDispatcherOption {
+	return func(d *QueueDispatcher) {
+		d.keyLimit = max(limit, 1)
+	}
+}
+
+// WithOverflowPolicy sets the policy applied when the queue limit is reached.
+// Shed updates are marked [Context.Overloaded] and handled by the handler set
+// with [WithOverloadHandler] instead of the router. They are processed one at
+// a time by a separate worker, so that a slow overload handler does not hold
+// up Dispatch; Dispatch blocks as with [OverflowBlock] while as many shed
+// updates as the queue limit are waiting for it.
+func WithOverflowPolicy(policy OverflowPolicy) QueueDispatcherOption {
+	return func(d *QueueDispatcher) {
+		d.policy = policy
+	}
+}
+
+var _ Dispatcher = (*QueueDispatcher)(nil)
+
+// QueueDispatcher processes updates sharing a key one at a time, in arrival
+// order, while different keys run in parallel bounded by a global
+// concurrency, so a busy chat does not stall others.
+type QueueDispatcher struct {
+	key         DispatchKeyFunc
+	concurrency int
+	limit       int
+	keyLimit    int
+	policy      OverflowPolicy
+
+	mu       sync.Mutex
+	pending  []dispatchTask
+	running  int
+	busy     map[int64]bool
+	depths   map[int64]int
+	space    chan struct{}
+	shedding []dispatchTask
+	shedBusy bool // the shed worker is running
+
+	wg sync.WaitGroup
+}
+
+type dispatchTask struct {
+	ctx     *Context
+	process func(*Context)
+	key     int64
+	ordered bool
+}
+
+// NewQueueDispatcher creates a QueueDispatcher running at most concurrency
+// updates at once. A nil key processes all updates without ordering.
+func NewQueueDispatcher(key DispatchKeyFunc, concurrency int, opts ...QueueDispatcherOption) *QueueDispatcher {
+	concurrency = max(concurrency, 1)
+
+	d := &QueueDispatcher{
+		key:         key,
+		concurrency: concurrency,
+		limit:       concurrency * pendingPerWorker,
+		busy:        make(map[int64]bool),
+		depths:      make(map[int64]int),
+		space:       make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+// Dispatch implements [Dispatcher].
+func (d *QueueDispatcher) Dispatch(ctx *Context, process func(*Context)) error {
+	task := dispatchTask{ctx: ctx, process: process}
+	if d.key != nil {
+		task.key, task.ordered = d.key(ctx)
+	}
+
+	d.mu.Lock()
+
+	for len(d.pending) >= d.limit || d.keyFull(task) {
+		if d.policy == OverflowBlock || len(d.shedding) >= d.limit {
+			space := d.space
+			d.mu.Unlock()
+
+			select {
+			case <-space:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+
+			d.mu.Lock()
+
+			continue
+		}
+
+		i := -1
+		if d.policy == OverflowDropOldest {
+			i = d.oldest(task)
+		}
+
+		if i < 0 {
+			d.shed(task)
+			d.mu.Unlock()
+
+			return nil
+		}
+
+		oldest := d.pending[i]
+		d.pending = slices.Delete(d.pending, i, i+1)
+		d.untrack(oldest)
+		d.shed(oldest)
+	}
+
+	d.pending = append(d.pending, task)
+	if task.ordered {
+		d.depths[task.key]++
+	}
+
+	d.schedule()
+	d.mu.Unlock()
+
+	return nil
+}
+
+// keyFull reports whether the key of task reached the key queue limit.
+// It must be called with d.mu held.
+func (d *QueueDispatcher) keyFull(task dispatchTask) bool {
+	return d.keyLimit > 0 && task.ordered && d.depths[task.key] >= d.keyLimit
+}
+
+// oldest returns the index of the pending task to shed to make room for
+// task, or -1 if the key of task is full and none of its updates is pending.
+// It must be called with d.mu held.
+func (d *QueueDispatcher) oldest(task dispatchTask) int {
+	if !d.keyFull(task) {
+		return 0
+	}
+
+	return slices.IndexFunc(d.pending, func(pending dispatchTask) bool {
+		return pending.ordered && pending.key == task.key
+	})
+}
+
+// shed queues an update the dispatcher refused to run for the shed worker,
+// which hands it to the client as overloaded. It must be called with d.mu held.
+func (d *QueueDispatcher) shed(task dispatchTask) {
+	task.ctx.overloaded = true
+	d.shedding = append(d.shedding, task)
+
+	if !d.shedBusy {
+		d.shedBusy = true
+		d.wg.Go(d.runShed)
+	}
+}
+
+// runShed processes shed updates in order until none is left.
+func (d *QueueDispatcher) runShed() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for len(d.shedding) != 0 {
+		task := d.shedding[0]
+		d.shedding = slices.Delete(d.shedding, 0, 1)
+		d.wakeUp()
+
+		d.mu.Unlock()
+		task.process(task.ctx)
+		d.mu.Lock()
+	}
+
+	d.shedBusy = false
+}
+
+// schedule starts pending tasks while workers are free, skipping tasks whose
+// key is busy. It must be called with d.mu held.
+func (d *QueueDispatcher) schedule() {
+	started := false
+
+	for i := 0; i < len(d.pending) && d.running < d.concurrency; {
+		task := d.pending[i]
+		if task.ordered && d.busy[task.key] {
+			i++
+			continue
+		}
+
+		d.pending = slices.Delete(d.pending, i, i+1)
+		d.running++
+		started = true
+
+		if task.ordered {
+			d.busy[task.key] = true
+		}
+
+		d.wg.Go(func() {
+			task.process(task.ctx)
+
+			d.mu.Lock()
+			d.running--
+			if task.ordered {
+				delete(d.busy, task.key)
+			}
+			d.untrack(task)
+			d.schedule()
+
+			if task.ordered && d.keyLimit > 0 {
+				d.wakeUp()
+			}
+			d.mu.Unlock()
+		})
+	}
+
+	if started {
+		d.wakeUp()
+	}
+}
+
+// wakeUp wakes up Dispatch calls blocked on a full queue. It must be called
+// with d.mu held.
+func (d *QueueDispatcher) wakeUp() {
+	close(d.space)
+	d.space = make(chan struct{})
+}
+
+func (d *QueueDispatcher) untrack(task dispatchTask) {
+	if !task.ordered {
+		return
+	}
+
+	if d.depths[task.key]--; d.depths[task.key] <= 0 {
+		delete(d.depths, task.key)
+	}
+}
+
+// Wait implements [Dispatcher].
+func (d *QueueDispatcher) Wait() {
+	d.wg.Wait()
+}
+
+// Pending returns the number of updates waiting for a worker.
+func (d *QueueDispatcher) Pending() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return len(d.pending)
+}
+
+// QueueDepth returns the number of queued and running updates for key.
+func (d *QueueDispatcher) QueueDepth(key int64) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.depths[key]
+}
+
+// QueueDepths returns a snapshot of the queued and running updates per key.
+func (d *QueueDispatcher) QueueDepths() map[int64]int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return maps.Clone(d.depths)
+}
@@ -0,0 +1,288 @@
+package gogram_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/darxnet/gogram"
+)
+
+func TestQueueDispatcher_BusyChatDoesNotBlockOthers(t *testing.T) {
+	t.Parallel()
+
+	client, err := gogram.NewClient(testToken)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	dispatcher := gogram.NewQueueDispatcher(gogram.DispatchByChat, 2)
+
+	release := make(chan struct{})
+	otherDone := make(chan struct{})
+
+	var mu sync.Mutex
+	var order []int64
+
+	process := func(ctx *gogram.Context) {
+		if ctx.Chat().ID == 1 {
+			<-release
+		} else {
+			close(otherDone)
+		}
+
+		mu.Lock()
+		order = append(order, ctx.Update().UpdateID)
+		mu.Unlock()
+	}
+
+	dispatch := func(updateID, chatID int64) {
+		update := &gogram.Update{UpdateID: updateID, Message: &gogram.Message{Chat: gogram.Chat{ID: chatID}}}
+		if err := dispatcher.Dispatch(gogram.NewTestContext(t.Context(), client, update), process); err != nil {
+			t.Fatalf("Dispatch: %v", err)
+		}
+	}
+
+	dispatch(1, 1)
+	dispatch(2, 1)
+	dispatch(3, 1)
+	dispatch(4, 2)
+
+	select {
+	case <-otherDone:
+	case <-time.After(time.Second):
+		t.Fatal("update of another chat was blocked by the busy chat")
+	}
+
+	if depth := dispatcher.QueueDepth(1); depth != 3 {
+		t.Errorf("QueueDepth(1) = %d, want 3", depth)
+	}
+
+	close(release)
+	dispatcher.Wait()
+
+	want := []int64{4, 1, 2, 3}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("processing order = %v, want %v", order, want)
+		}
+	}
+
+	if depths := dispatcher.QueueDepths(); len(depths) != 0 {
+		t.Errorf("expected empty queues after Wait, got %v", depths)
+	}
+}
+
+func TestQueueDispatcher_OverflowPolicies(t *testing.T) {
+	t.Parallel()
+
+	client, err := gogram.NewClient(testToken)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	for _, tt := range []struct {
+		name   string
+		policy gogram.OverflowPolicy
+		shed   int64
+	}{
+		{name: "drop newest", policy: gogram.OverflowDropNewest, shed: 3},
+		{name: "drop oldest", policy: gogram.OverflowDropOldest, shed: 2},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dispatcher := gogram.NewQueueDispatcher(nil, 1,
+				gogram.WithQueueLimit(1),
+				gogram.WithOverflowPolicy(tt.policy),
+			)
+
+			release := make(chan struct{})
+			started := make(chan struct{})
+
+			var mu sync.Mutex
+			var processed, shed []int64
+
+			process := func(ctx *gogram.Context) {
+				id := ctx.Update().UpdateID
+				if ctx.Overloaded() {
+					mu.Lock()
+					shed = append(shed, id)
+					mu.Unlock()
+					return
+				}
+
+				if id == 1 {
+					close(started)
+					<-release
+				}
+
+				mu.Lock()
+				processed = append(processed, id)
+				mu.Unlock()
+			}
+
+			for id := range int64(3) {
+				update := &gogram.Update{UpdateID: id + 1}
+				if err := dispatcher.Dispatch(gogram.NewTestContext(t.Context(), client, update), process); err != nil {
+					t.Fatalf("Dispatch: %v", err)
+				}
+				if id == 0 {
+					<-started
+				}
+			}
+
+			close(release)
+			dispatcher.Wait()
+
+			if len(shed) != 1 || shed[0] != tt.shed {
+				t.Errorf("shed = %v, want [%d]", shed, tt.shed)
+			}
+			if len(processed) != 2 {
+				t.Errorf("processed = %v, want 2 updates", processed)
+			}
+		})
+	}
+}
+
+func TestQueueDispatcher_ShedDoesNotBlockDispatch(t *testing.T) {
+	t.Parallel()
+
+	client, err := gogram.NewClient(testToken)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	dispatcher := gogram.NewQueueDispatcher(nil, 1,
+		gogram.WithQueueLimit(2),
+		gogram.WithOverflowPolicy(gogram.OverflowDropNewest),
+	)
+
+	release := make(chan struct{})
+
+	var mu sync.Mutex
+	var shed []int64
+
+	process := func(ctx *gogram.Context) {
+		<-release
+
+		if ctx.Overloaded() {
+			mu.Lock()
+			shed = append(shed, ctx.Update().UpdateID)
+			mu.Unlock()
+		}
+	}
+
+	dispatched := make(chan struct{})
+	go func() {
+		defer close(dispatched)
+
+		for id := range int64(5) {
+			update := &gogram.Update{UpdateID: id + 1}
+			if err := dispatcher.Dispatch(gogram.NewTestContext(t.Context(), client, update), process); err != nil {
+				t.Errorf("Dispatch: %v", err)
+			}
+		}
+	}()
+
+	select {
+	case <-dispatched:
+	case <-time.After(time.Second):
+		t.Fatal("Dispatch was blocked by the overload handler")
+	}
+
+	close(release)
+	dispatcher.Wait()
+
+	if len(shed) != 2 || shed[0] != 4 || shed[1] != 5 {
+		t.Errorf("shed = %v, want [4 5]", shed)
+	}
+}
+
+func TestQueueDispatcher_KeyQueueLimit(t *testing.T) {
+	t.Parallel()
+
+	client, err := gogram.NewClient(testToken)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	dispatcher := gogram.NewQueueDispatcher(gogram.DispatchByChat, 2,
+		gogram.WithQueueLimit(4),
+		gogram.WithKeyQueueLimit(2),
+		gogram.WithOverflowPolicy(gogram.OverflowBlock),
+	)
+
+	release := make(chan struct{})
+	otherDone := make(chan struct{})
+
+	var mu sync.Mutex
+	var order []int64
+
+	process := func(ctx *gogram.Context) {
+		if ctx.Chat().ID == 1 {
+			<-release
+		} else {
+			close(otherDone)
+		}
+
+		mu.Lock()
+		order = append(order, ctx.Update().UpdateID)
+		mu.Unlock()
+	}
+
+	dispatch := func(ctx context.Context, updateID, chatID int64) error {
+		update := &gogram.Update{UpdateID: updateID, Message: &gogram.Message{Chat: gogram.Chat{ID: chatID}}}
+		return dispatcher.Dispatch(gogram.NewTestContext(ctx, client, update), process)
+	}
+
+	// The busy chat sends more updates than the global limit.
+	busyDone := make(chan struct{})
+	go func() {
+		defer close(busyDone)
+
+		for id := range int64(6) {
+			if err := dispatch(t.Context(), id+1, 1); err != nil {
+				t.Errorf("Dispatch: %v", err)
+			}
+		}
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for dispatcher.QueueDepth(1) < 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("busy chat updates were not queued")
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+	defer cancel()
+
+	if err := dispatch(ctx, 7, 2); err != nil {
+		t.Fatalf("Dispatch for another chat: %v", err)
+	}
+
+	select {
+	case <-otherDone:
+	case <-time.After(time.Second):
+		t.Fatal("update of another chat was blocked by the busy chat")
+	}
+
+	if depth := dispatcher.QueueDepth(1); depth != 2 {
+		t.Errorf("QueueDepth(1) = %d, want 2", depth)
+	}
+
+	close(release)
+	<-busyDone
+	dispatcher.Wait()
+
+	want := []int64{7, 1, 2, 3, 4, 5, 6}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("processing order = %v, want %v", order, want)
+		}
+	}
+}
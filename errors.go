@@ -2,7 +2,10 @@ package gogram
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 )
 
@@ -66,6 +69,72 @@ func (e *MigrateError) Unwrap() error {
 	return e.Err
 }
 
+// RequestError reports a failed request to the Bot API. It is returned by
+// [Client.Raw], and so by all API methods, and by [Client.ReceiveFileReader].
+// The bot token is redacted from the wrapped error.
+type RequestError struct {
+	// Method is the Bot API method name, empty for file downloads.
+	Method string
+	// StatusCode is the HTTP status code, zero if no response was received.
+	StatusCode int
+	Err        error
+}
+
+func (e *RequestError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// redactedToken replaces the bot token in errors.
+const redactedToken = "<token>"
+
+// redactedError hides the bot token in the text of an error.
+type redactedError struct {
+	text string
+	err  error
+}
+
+func (e *redactedError) Error() string {
+	return e.text
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+// redactError returns err with the bot token replaced by a placeholder.
+// URL errors are rebuilt to keep their Timeout and Temporary methods.
+func redactError(err error, token string) error {
+	if err == nil || token == "" || !strings.Contains(err.Error(), token) {
+		return err
+	}
+
+	if urlErr, ok := err.(*url.Error); ok { //nolint:errorlint // only the outermost error is rebuilt
+		return &url.Error{
+			Op:  urlErr.Op,
+			URL: strings.ReplaceAll(urlErr.URL, token, redactedToken),
+			Err: redactError(urlErr.Err, token),
+		}
+	}
+
+	return &redactedError{
+		text: strings.ReplaceAll(err.Error(), token, redactedToken),
+		err:  err,
+	}
+}
+
+// PanicError reports a panic recovered from a handler, e.g. to an [Ack].
+type PanicError struct {
+	Value any
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("gogram: recovered panic: %v", e.Value)
+}
+
 // NewError creates a new Error with the given code and text.
 func NewError(code int, text string) error {
 	return &Error{Code: code, Text: text}
@@ -240,6 +309,8 @@ func genErrorNotFound(description string) error {
 var (
 	// ErrConflictWithBot indicates another getUpdates consumer is already running.
 	ErrConflictWithBot = NewError(http.StatusConflict, "Conflict: terminated by other getUpdates request; make sure that only one bot instance is running")
+	// ErrConflictWebhookActive indicates that getUpdates was called while a webhook is set.
+	ErrConflictWebhookActive = NewError(http.StatusConflict, "Conflict: can't use getUpdates method while webhook is active; use deleteWebhook to delete the webhook first")
 )
 
 func genErrorConflict(description string) error {
@@ -247,6 +318,9 @@ func genErrorConflict(description string) error {
 	case ErrConflictWithBot.Error():
 		return ErrConflictWithBot
 
+	case ErrConflictWebhookActive.Error():
+		return ErrConflictWebhookActive
+
 	default:
 		return nil
 	}
@@ -33,17 +33,19 @@ func (c *Client) ReceiveFileReader(ctx context.Context, file *File) (io.ReadClos
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, http.NoBody)
 	if err != nil {
+		err = &RequestError{Err: redactError(err, c.token)}
 		return nil, fmt.Errorf("gogram: failed to create request: %w", err)
 	}
 
 	resp, err := c.Do(req)
 	if err != nil {
+		err = &RequestError{Err: redactError(err, c.token)}
 		return nil, fmt.Errorf("gogram: failed to download file: %w", err)
 	}
 
 	if resp.StatusCode != http.StatusOK {
 		_ = resp.Body.Close()
-		err := NewError(resp.StatusCode, resp.Status)
+		err = &RequestError{StatusCode: resp.StatusCode, Err: NewError(resp.StatusCode, resp.Status)}
 		return nil, fmt.Errorf("gogram: failed to download file: %w", err)
 	}
 
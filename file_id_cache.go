@@ -0,0 +1,270 @@
+package gogram
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+)
+
+// FileIDStore persists the file IDs of uploaded files, so that the same
+// content is uploaded once and then sent by its file ID.
+type FileIDStore interface {
+	// LoadFileID returns the file ID stored for key, or an empty string.
+	LoadFileID(ctx context.Context, botID int64, key string) (string, error)
+	// SaveFileID stores the file ID of key.
+	SaveFileID(ctx context.Context, botID int64, key, fileID string) error
+	// DeleteFileID forgets the file ID of key.
+	DeleteFileID(ctx context.Context, botID int64, key string) error
+}
+
+// WithFileIDCache makes files sent with sendPhoto, sendDocument and other
+// methods sending a single file be uploaded once: the file ID of the sent
+// message is saved in store and sent instead of the file afterwards.
+//
+// Files are identified by [InputFile.CacheKey], or else by the SHA-256 hash
+// of their contents if they were created with [InputFileFromPath],
+// [InputFileFromBytes] or [InputFileFromFS]; other files are always uploaded.
+// A file ID rejected with [ErrBadRequestWrongRemoteFileIdentifierSpecified]
+// is deleted, so the call fails but the next one uploads the file again.
+func WithFileIDCache(store FileIDStore) ClientOption {
+	return func(c *Client) {
+		c.cfg.fileIDStore = store
+	}
+}
+
+// messageFileIDs maps the methods whose files are cached to the parameter
+// holding the file and to the file ID of the sent message.
+var messageFileIDs = map[string]struct {
+	param  string
+	fileID func(m *Message) string
+}{
+	"sendPhoto": {"photo", func(m *Message) string {
+		if p := PhotoBiggest(m.Photo); p != nil {
+			return p.FileID
+		}
+
+		return ""
+	}},
+	"sendDocument": {"document", func(m *Message) string {
+		if m.Document != nil {
+			return m.Document.FileID
+		}
+
+		return ""
+	}},
+	"sendVideo": {"video", func(m *Message) string {
+		if m.Video != nil {
+			return m.Video.FileID
+		}
+
+		return ""
+	}},
+	"sendAudio": {"audio", func(m *Message) string {
+		if m.Audio != nil {
+			return m.Audio.FileID
+		}
+
+		return ""
+	}},
+	"sendAnimation": {"animation", func(m *Message) string {
+		if m.Animation != nil {
+			return m.Animation.FileID
+		}
+
+		return ""
+	}},
+	"sendVoice": {"voice", func(m *Message) string {
+		if m.Voice != nil {
+			return m.Voice.FileID
+		}
+
+		return ""
+	}},
+	"sendVideoNote": {"video_note", func(m *Message) string {
+		if m.VideoNote != nil {
+			return m.VideoNote.FileID
+		}
+
+		return ""
+	}},
+	"sendSticker": {"sticker", func(m *Message) string {
+		if m.Sticker != nil {
+			return m.Sticker.FileID
+		}
+
+		return ""
+	}},
+}
+
+// formFileIDs tracks the cached file of a multipart call.
+type formFileIDs struct {
+	client *Client
+	ctx    context.Context
+	method string
+
+	key      string // empty if the file is not cached
+	uploaded bool
+}
+
+// newMultipartForm returns the body of a multipart call of method, which
+// looks up the file IDs of uploaded files if a [FileIDStore] is set.
+func (c *Client) newMultipartForm(ctx context.Context, method string) *multipartForm {
+	form := newMultipartForm()
+
+	if _, ok := messageFileIDs[method]; ok && c.cfg.fileIDStore != nil {
+		form.fileIDs = &formFileIDs{client: c, ctx: ctx, method: method}
+	}
+
+	return form
+}
+
+// cachedFileID returns the stored file ID of f sent as param, or an empty
+// string if f has to be uploaded.
+func (form *multipartForm) cachedFileID(f *InputFile, param string) string {
+	cache := form.fileIDs
+	if cache == nil || messageFileIDs[cache.method].param != param {
+		return ""
+	}
+
+	key := f.CacheKey
+	if key == "" {
+		if f.open == nil {
+			return ""
+		}
+
+		sum, err := hashInputFile(f)
+		if err != nil {
+			// The upload reports the error.
+			return ""
+		}
+
+		key = "sha256:" + sum
+	}
+
+	// File IDs of one kind cannot be sent as another, e.g. a photo as a document.
+	cache.key = param + ":" + key
+
+	store := cache.client.cfg.fileIDStore
+
+	fileID, err := store.LoadFileID(cache.ctx, cache.client.id, cache.key)
+	if err != nil {
+		cache.client.log(cache.ctx, slog.LevelWarn, "gogram: loading cached file ID failed",
+			slog.String("key", cache.key), slog.Any("error", err))
+	}
+
+	cache.uploaded = fileID == ""
+
+	if !cache.uploaded && f.File != nil {
+		// Closed by release like an uploaded file.
+		form.files = append(form.files, formFile{reader: f.File, name: f.FileName, size: -1})
+	}
+
+	return fileID
+}
+
+// finish saves the file ID of an uploaded file from the result of the call,
+// or deletes a cached file ID rejected by Telegram.
+func (cache *formFileIDs) finish(ctx context.Context, result json.RawMessage, err error) {
+	if cache.key == "" {
+		return
+	}
+
+	store := cache.client.cfg.fileIDStore
+
+	switch {
+	case err == nil && cache.uploaded:
+		var msg Message
+		if json.Unmarshal(result, &msg) != nil {
+			return
+		}
+
+		fileID := messageFileIDs[cache.method].fileID(&msg)
+		if fileID == "" {
+			return
+		}
+
+		err = store.SaveFileID(ctx, cache.client.id, cache.key, fileID)
+
+	case errors.Is(err, ErrBadRequestWrongRemoteFileIdentifierSpecified) && !cache.uploaded:
+		err = store.DeleteFileID(ctx, cache.client.id, cache.key)
+
+	default:
+		return
+	}
+
+	if err != nil {
+		cache.client.log(ctx, slog.LevelWarn, "gogram: updating cached file ID failed",
+			slog.String("key", cache.key), slog.Any("error", err))
+	}
+}
+
+// hashInputFile returns the hex encoded SHA-256 hash of the contents of f.
+func hashInputFile(f *InputFile) (string, error) {
+	r, err := f.open()
+	if err != nil {
+		return "", err
+	}
+
+	if closer, ok := r.(io.Closer); ok {
+		defer closer.Close() //nolint:errcheck
+	}
+
+	hash := sha256.New()
+	if _, err = io.Copy(hash, r); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+var _ FileIDStore = (*MemoryFileIDStore)(nil)
+
+// MemoryFileIDStore is an in-memory [FileIDStore].
+type MemoryFileIDStore struct {
+	mu      sync.RWMutex
+	fileIDs map[int64]map[string]string
+}
+
+// NewMemoryFileIDStore creates an empty MemoryFileIDStore.
+func NewMemoryFileIDStore() *MemoryFileIDStore {
+	return &MemoryFileIDStore{fileIDs: make(map[int64]map[string]string)}
+}
+
+// LoadFileID implements [FileIDStore].
+func (s *MemoryFileIDStore) LoadFileID(_ context.Context, botID int64, key string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.fileIDs[botID][key], nil
+}
+
+// SaveFileID implements [FileIDStore].
+func (s *MemoryFileIDStore) SaveFileID(_ context.Context, botID int64, key, fileID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fileIDs := s.fileIDs[botID]
+	if fileIDs == nil {
+		fileIDs = make(map[string]string)
+		s.fileIDs[botID] = fileIDs
+	}
+
+	fileIDs[key] = fileID
+
+	return nil
+}
+
+// DeleteFileID implements [FileIDStore].
+func (s *MemoryFileIDStore) DeleteFileID(_ context.Context, botID int64, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.fileIDs[botID], key)
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package gogram_test
+
+import (
+	"encoding/json"
+	"errors"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"testing"
+
+	"github.com/darxnet/gogram"
+)
+
+func TestClient_SendPhoto_FileIDCache(t *testing.T) {
+	t.Parallel()
+
+	type sent struct {
+		upload bool
+		fileID string
+	}
+
+	var (
+		requests []sent
+		reject   bool
+	)
+
+	httpClient := &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			_, params, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+
+			form, err := multipart.NewReader(req.Body, params["boundary"]).ReadForm(1 << 20)
+			if err != nil {
+				t.Fatalf("ReadForm: %v", err)
+			}
+
+			var s sent
+			if files := form.File["photo"]; len(files) != 0 {
+				s.upload = true
+			}
+
+			if values := form.Value["photo"]; len(values) != 0 {
+				s.fileID = values[0]
+			}
+
+			requests = append(requests, s)
+
+			if reject && !s.upload {
+				return jsonHTTPResponse(t, &gogram.Response{
+					ErrorCode:   http.StatusBadRequest,
+					Description: gogram.ErrBadRequestWrongRemoteFileIdentifierSpecified.Error(),
+					Result:      json.RawMessage(`null`),
+				}), nil
+			}
+
+			return jsonHTTPResponse(t, &gogram.Response{OK: true, Result: json.RawMessage(`{"message_id":1,"photo":[
+				{"file_id":"small","file_unique_id":"s","width":90,"height":90},
+				{"file_id":"big","file_unique_id":"b","width":800,"height":800}
+			]}`)}), nil
+		}),
+	}
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost("example.invalid"),
+		gogram.WithHTTPClient(httpClient),
+		gogram.WithFileIDCache(gogram.NewMemoryFileIDStore()),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	send := func() error {
+		_, err := client.SendPhoto(t.Context(), &gogram.SendPhotoParams{
+			ChatID: "1",
+			Photo:  gogram.InputFileFromBytes("menu.png", []byte("\x89PNG\r\n\x1a\nmenu")),
+		})
+
+		return err
+	}
+
+	for range 2 {
+		if err = send(); err != nil {
+			t.Fatalf("SendPhoto: %v", err)
+		}
+	}
+
+	reject = true
+
+	if err = send(); !errors.Is(err, gogram.ErrBadRequestWrongRemoteFileIdentifierSpecified) {
+		t.Fatalf("expected ErrBadRequestWrongRemoteFileIdentifierSpecified, got %v", err)
+	}
+
+	if err = send(); err != nil {
+		t.Fatalf("SendPhoto after invalidation: %v", err)
+	}
+
+	want := []sent{{upload: true}, {fileID: "big"}, {fileID: "big"}, {upload: true}}
+	if len(requests) != len(want) {
+		t.Fatalf("got %d requests, want %d", len(requests), len(want))
+	}
+
+	for i := range want {
+		if requests[i] != want[i] {
+			t.Errorf("request %d = %+v, want %+v", i, requests[i], want[i])
+		}
+	}
+}
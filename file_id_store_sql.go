@@ -0,0 +1,95 @@
+package gogram
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+var _ FileIDStore = (*SQLFileIDStore)(nil)
+
+// SQLFileIDStore is a [database/sql] backed [FileIDStore].
+//
+// Like [SQLUpdateStore], it uses portable SQL only and accepts the same
+// options. Call [SQLFileIDStore.Init] once to create the table.
+type SQLFileIDStore struct {
+	db  *sql.DB
+	cfg sqlStoreConfig
+
+	querySelect string
+	queryUpdate string
+	queryInsert string
+	queryDelete string
+}
+
+// NewSQLFileIDStore creates an SQLFileIDStore on top of db.
+func NewSQLFileIDStore(db *sql.DB, opts ...SQLStoreOption) *SQLFileIDStore {
+	cfg := newSQLStoreConfig(opts)
+
+	fileIDs := cfg.prefix + "file_ids"
+
+	return &SQLFileIDStore{
+		db:  db,
+		cfg: cfg,
+
+		querySelect: cfg.rebind("SELECT file_id FROM " + fileIDs + " WHERE bot_id = ? AND file_key = ?"),
+		queryUpdate: cfg.rebind("UPDATE " + fileIDs + " SET file_id = ? WHERE bot_id = ? AND file_key = ?"),
+		queryInsert: cfg.rebind("INSERT INTO " + fileIDs + " (bot_id, file_key, file_id) VALUES (?, ?, ?)"),
+		queryDelete: cfg.rebind("DELETE FROM " + fileIDs + " WHERE bot_id = ? AND file_key = ?"),
+	}
+}
+
+// Init creates the store table if it does not exist.
+func (s *SQLFileIDStore) Init(ctx context.Context) error {
+	_, err := s.db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+s.cfg.prefix+"file_ids ("+
+		"bot_id BIGINT NOT NULL, "+
+		"file_key VARCHAR(255) NOT NULL, "+
+		"file_id VARCHAR(255) NOT NULL, "+
+		"PRIMARY KEY (bot_id, file_key))")
+
+	return err
+}
+
+// LoadFileID implements [FileIDStore].
+func (s *SQLFileIDStore) LoadFileID(ctx context.Context, botID int64, key string) (string, error) {
+	var fileID string
+
+	err := s.db.QueryRowContext(ctx, s.querySelect, botID, key).Scan(&fileID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+
+	return fileID, err
+}
+
+// SaveFileID implements [FileIDStore].
+func (s *SQLFileIDStore) SaveFileID(ctx context.Context, botID int64, key, fileID string) error {
+	res, err := s.db.ExecContext(ctx, s.queryUpdate, fileID, botID, key)
+	if err != nil {
+		return err
+	}
+
+	if n, _ := res.RowsAffected(); n != 0 {
+		return nil
+	}
+
+	_, err = s.db.ExecContext(ctx, s.queryInsert, botID, key, fileID)
+	if err == nil {
+		return nil
+	}
+
+	// MySQL reports zero affected rows for an unchanged value, and a
+	// concurrent upload may have inserted the same key.
+	if stored, loadErr := s.LoadFileID(ctx, botID, key); loadErr == nil && stored != "" {
+		return nil
+	}
+
+	return err
+}
+
+// DeleteFileID implements [FileIDStore].
+func (s *SQLFileIDStore) DeleteFileID(ctx context.Context, botID int64, key string) error {
+	_, err := s.db.ExecContext(ctx, s.queryDelete, botID, key)
+
+	return err
+}
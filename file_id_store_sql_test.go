@@ -0,0 +1,168 @@
+package gogram_test
+
+import (
+	"database/sql/driver"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/darxnet/gogram"
+)
+
+// fakeFileIDDB emulates the table of SQLFileIDStore.
+type fakeFileIDDB struct {
+	fileIDs map[fakeFileIDKey]string
+}
+
+type fakeFileIDKey struct {
+	botID int64
+	key   string
+}
+
+func (db *fakeFileIDDB) handle(query string, args []driver.Value) (fakeSQLResult, error) {
+	key := func(botID, fileKey driver.Value) fakeFileIDKey {
+		return fakeFileIDKey{botID.(int64), fileKey.(string)}
+	}
+
+	switch {
+	case strings.HasPrefix(query, "SELECT file_id FROM gogram_file_ids"):
+		if fileID, ok := db.fileIDs[key(args[0], args[1])]; ok {
+			return fakeSQLResult{rows: []driver.Value{fileID}}, nil
+		}
+
+		return fakeSQLResult{}, nil
+
+	case strings.HasPrefix(query, "UPDATE gogram_file_ids"):
+		k := key(args[1], args[2])
+		if _, ok := db.fileIDs[k]; !ok {
+			return fakeSQLResult{}, nil
+		}
+
+		db.fileIDs[k] = args[0].(string)
+
+		return fakeSQLResult{affected: 1}, nil
+
+	case strings.HasPrefix(query, "INSERT INTO gogram_file_ids"):
+		k := key(args[0], args[1])
+		if _, ok := db.fileIDs[k]; ok {
+			return fakeSQLResult{}, errFakeSQLDuplicate
+		}
+
+		db.fileIDs[k] = args[2].(string)
+
+		return fakeSQLResult{affected: 1}, nil
+
+	case strings.HasPrefix(query, "DELETE FROM gogram_file_ids"):
+		k := key(args[0], args[1])
+		if _, ok := db.fileIDs[k]; !ok {
+			return fakeSQLResult{}, nil
+		}
+
+		delete(db.fileIDs, k)
+
+		return fakeSQLResult{affected: 1}, nil
+	}
+
+	return fakeSQLResult{}, errors.New("unexpected query: " + query)
+}
+
+func TestSQLFileIDStore(t *testing.T) {
+	t.Parallel()
+
+	fakeDB := &fakeFileIDDB{fileIDs: make(map[fakeFileIDKey]string)}
+
+	f, db := newFakeSQL(t, fakeDB.handle)
+	store := gogram.NewSQLFileIDStore(db)
+
+	load := func(botID int64, key, want string) {
+		t.Helper()
+
+		fileID, err := store.LoadFileID(t.Context(), botID, key)
+		if err != nil {
+			t.Fatalf("LoadFileID(%d, %q): %v", botID, key, err)
+		}
+
+		if fileID != want {
+			t.Errorf("LoadFileID(%d, %q) = %q, want %q", botID, key, fileID, want)
+		}
+	}
+
+	load(1, "photo:a", "")
+
+	if err := store.SaveFileID(t.Context(), 1, "photo:a", "first"); err != nil {
+		t.Fatalf("SaveFileID: %v", err)
+	}
+
+	if err := store.SaveFileID(t.Context(), 1, "photo:a", "second"); err != nil {
+		t.Fatalf("SaveFileID: %v", err)
+	}
+
+	load(1, "photo:a", "second")
+	load(2, "photo:a", "")
+
+	if err := store.DeleteFileID(t.Context(), 1, "photo:a"); err != nil {
+		t.Fatalf("DeleteFileID: %v", err)
+	}
+
+	load(1, "photo:a", "")
+
+	want := []string{
+		"SELECT file_id FROM gogram_file_ids WHERE bot_id = ? AND file_key = ?",
+		// The first save inserts the missing row, the second one updates it.
+		"UPDATE gogram_file_ids SET file_id = ? WHERE bot_id = ? AND file_key = ?",
+		"INSERT INTO gogram_file_ids (bot_id, file_key, file_id) VALUES (?, ?, ?)",
+		"UPDATE gogram_file_ids SET file_id = ? WHERE bot_id = ? AND file_key = ?",
+		"SELECT file_id FROM gogram_file_ids WHERE bot_id = ? AND file_key = ?",
+		"SELECT file_id FROM gogram_file_ids WHERE bot_id = ? AND file_key = ?",
+		"DELETE FROM gogram_file_ids WHERE bot_id = ? AND file_key = ?",
+		"SELECT file_id FROM gogram_file_ids WHERE bot_id = ? AND file_key = ?",
+	}
+	if queries := f.Queries(); !slices.Equal(queries, want) {
+		t.Errorf("queries = %q, want %q", queries, want)
+	}
+}
+
+func TestSQLFileIDStore_SaveInsertFails(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name    string
+		stored  bool
+		wantErr bool
+	}{
+		// MySQL reports no affected rows for an unchanged value.
+		{name: "row exists", stored: true},
+		{name: "row missing", wantErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fakeDB := &fakeFileIDDB{fileIDs: make(map[fakeFileIDKey]string)}
+			if tt.stored {
+				fakeDB.fileIDs[fakeFileIDKey{1, "photo:a"}] = "same"
+			}
+
+			_, db := newFakeSQL(t, func(query string, args []driver.Value) (fakeSQLResult, error) {
+				switch {
+				case strings.HasPrefix(query, "UPDATE"):
+					return fakeSQLResult{}, nil
+
+				case strings.HasPrefix(query, "INSERT"):
+					return fakeSQLResult{}, errFakeSQLDuplicate
+				}
+
+				return fakeDB.handle(query, args)
+			})
+
+			err := gogram.NewSQLFileIDStore(db).SaveFileID(t.Context(), 1, "photo:a", "same")
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("SaveFileID = %v, want error %t", err, tt.wantErr)
+			}
+
+			if tt.wantErr && !errors.Is(err, errFakeSQLDuplicate) {
+				t.Errorf("SaveFileID = %v, want the insert error", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,463 @@
+package gogram
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Helpers of the MarshalJSON and UnmarshalJSON methods generated in
+// json.gen.go, which encode and decode fields of basic types without
+// reflection and leave anything else to encoding/json, and of the response
+// scanner used by Client.raw.
+
+var errJSONSyntax = errors.New("gogram: invalid JSON")
+
+const jsonHex = "0123456789abcdef"
+
+func jsonSyntaxError(expected string, offset int) error {
+	return fmt.Errorf("%w: expected %s at offset %d", errJSONSyntax, expected, offset)
+}
+
+// appendJSONKey appends the key of an object member, preceded by a comma
+// unless it is the first member of the object whose body starts at start.
+func appendJSONKey(b []byte, start int, key string) []byte {
+	if len(b) > start {
+		b = append(b, ',')
+	}
+
+	b = append(b, '"')
+	b = append(b, key...)
+
+	return append(b, '"', ':')
+}
+
+// appendJSONString appends s as a JSON string, escaped the way encoding/json does.
+func appendJSONString(b []byte, s string) []byte {
+	b = append(b, '"')
+
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+
+			b = append(b, s[start:i]...)
+
+			switch c {
+			case '"', '\\':
+				b = append(b, '\\', c)
+
+			case '\b':
+				b = append(b, '\\', 'b')
+
+			case '\f':
+				b = append(b, '\\', 'f')
+
+			case '\n':
+				b = append(b, '\\', 'n')
+
+			case '\r':
+				b = append(b, '\\', 'r')
+
+			case '\t':
+				b = append(b, '\\', 't')
+
+			default:
+				b = append(b, '\\', 'u', '0', '0', jsonHex[c>>4], jsonHex[c&0xf])
+			}
+
+			i++
+			start = i
+
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+
+		switch {
+		case r == utf8.RuneError && size == 1:
+			b = append(b, s[start:i]...)
+			b = append(b, "\ufffd"...)
+
+		case r == '\u2028' || r == '\u2029':
+			b = append(b, s[start:i]...)
+			b = append(b, '\\', 'u', '2', '0', '2', jsonHex[r&0xf])
+
+		default:
+			i += size
+			continue
+		}
+
+		i += size
+		start = i
+	}
+
+	b = append(b, s[start:]...)
+
+	return append(b, '"')
+}
+
+func appendJSONInt(b []byte, v int64) []byte {
+	return strconv.AppendInt(b, v, 10)
+}
+
+func appendJSONBool(b []byte, v bool) []byte {
+	return strconv.AppendBool(b, v)
+}
+
+// appendJSONValue appends v encoded with encoding/json.
+func appendJSONValue(b []byte, v any) ([]byte, error) {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return b, err
+	}
+
+	return append(b, bs...), nil
+}
+
+// decodeJSONValue decodes data into v with encoding/json.
+func decodeJSONValue(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+// decodeJSONString decodes a JSON string into v. Valid UTF-8 strings without
+// escape sequences are copied directly, anything else, null included, is left
+// to encoding/json.
+func decodeJSONString(data []byte, v *string) error {
+	if len(data) >= 2 && data[0] == '"' {
+		s := data[1 : len(data)-1]
+		if bytes.IndexByte(s, '\\') < 0 && utf8.Valid(s) {
+			*v = string(s)
+			return nil
+		}
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// decodeJSONInt decodes a JSON integer into v. Integers that surely fit into
+// an int64 are parsed directly, anything else is left to encoding/json.
+func decodeJSONInt(data []byte, v *int64) error {
+	digits := data
+	if len(digits) != 0 && digits[0] == '-' {
+		digits = digits[1:]
+	}
+
+	if len(digits) == 0 || len(digits) > 18 || digits[0] == '0' && len(digits) > 1 {
+		return json.Unmarshal(data, v)
+	}
+
+	var n int64
+
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return json.Unmarshal(data, v)
+		}
+
+		n = n*10 + int64(c-'0')
+	}
+
+	if len(digits) != len(data) {
+		n = -n
+	}
+
+	*v = n
+
+	return nil
+}
+
+// decodeJSONBool decodes a JSON boolean into v.
+func decodeJSONBool(data []byte, v *bool) error {
+	switch string(data) {
+	case "true":
+		*v = true
+
+	case "false":
+		*v = false
+
+	default:
+		return json.Unmarshal(data, v)
+	}
+
+	return nil
+}
+
+// jsonDecoder is a pointer to a type with a generated UnmarshalJSON.
+type jsonDecoder[T any] interface {
+	*T
+	UnmarshalJSON(data []byte) error
+}
+
+// decodeJSONObject decodes a JSON object into *v, allocating it if needed,
+// or sets it to nil for null, like encoding/json.
+func decodeJSONObject[T any, P jsonDecoder[T]](data []byte, v **T) error {
+	if string(data) == "null" {
+		*v = nil
+		return nil
+	}
+
+	if *v == nil {
+		*v = new(T)
+	}
+
+	return P(*v).UnmarshalJSON(data)
+}
+
+// decodeJSONArray decodes a JSON array into *v, reusing its backing array,
+// or sets it to nil for null, like encoding/json.
+func decodeJSONArray[T any, P jsonDecoder[T]](data []byte, v *[]T) error {
+	if string(data) == "null" {
+		*v = nil
+		return nil
+	}
+
+	if len(data) == 0 || data[0] != '[' {
+		return json.Unmarshal(data, v)
+	}
+
+	s := (*v)[:0]
+	if s == nil {
+		s = make([]T, 0)
+	}
+
+	err := scanJSONArray(data, func(value []byte) error {
+		var zero T
+		s = append(s, zero)
+
+		return P(&s[len(s)-1]).UnmarshalJSON(value)
+	})
+
+	*v = s
+
+	return err
+}
+
+// scanJSONObject calls fn with the key and the raw value of each member of the
+// JSON object in data. A null data is treated as an empty object.
+func scanJSONObject(data []byte, fn func(key, value []byte) error) error {
+	i := skipJSONSpace(data, 0)
+	if i == len(data) || data[i] != '{' {
+		if string(bytes.TrimSpace(data)) == "null" {
+			return nil
+		}
+
+		return jsonSyntaxError("object", i)
+	}
+
+	i = skipJSONSpace(data, i+1)
+	if i < len(data) && data[i] == '}' {
+		return checkJSONEnd(data, i+1)
+	}
+
+	for {
+		if i == len(data) || data[i] != '"' {
+			return jsonSyntaxError("object key", i)
+		}
+
+		end, escaped, err := scanJSONString(data, i)
+		if err != nil {
+			return err
+		}
+
+		key := data[i+1 : end-1]
+		if escaped {
+			var s string
+			if err = json.Unmarshal(data[i:end], &s); err != nil {
+				return err
+			}
+
+			key = []byte(s)
+		}
+
+		i = skipJSONSpace(data, end)
+		if i == len(data) || data[i] != ':' {
+			return jsonSyntaxError("colon", i)
+		}
+
+		i = skipJSONSpace(data, i+1)
+
+		end, err = skipJSONValue(data, i)
+		if err != nil {
+			return err
+		}
+
+		if err = fn(key, data[i:end]); err != nil {
+			return err
+		}
+
+		i = skipJSONSpace(data, end)
+		if i == len(data) {
+			return jsonSyntaxError("comma or closing brace", i)
+		}
+
+		switch data[i] {
+		case ',':
+			i = skipJSONSpace(data, i+1)
+
+		case '}':
+			return checkJSONEnd(data, i+1)
+
+		default:
+			return jsonSyntaxError("comma or closing brace", i)
+		}
+	}
+}
+
+// scanJSONArray calls fn with each raw element of the JSON array in data.
+func scanJSONArray(data []byte, fn func(value []byte) error) error {
+	i := skipJSONSpace(data, 0)
+	if i == len(data) || data[i] != '[' {
+		return jsonSyntaxError("array", i)
+	}
+
+	i = skipJSONSpace(data, i+1)
+	if i < len(data) && data[i] == ']' {
+		return checkJSONEnd(data, i+1)
+	}
+
+	for {
+		end, err := skipJSONValue(data, i)
+		if err != nil {
+			return err
+		}
+
+		if err = fn(data[i:end]); err != nil {
+			return err
+		}
+
+		i = skipJSONSpace(data, end)
+		if i == len(data) {
+			return jsonSyntaxError("comma or closing bracket", i)
+		}
+
+		switch data[i] {
+		case ',':
+			i = skipJSONSpace(data, i+1)
+
+		case ']':
+			return checkJSONEnd(data, i+1)
+
+		default:
+			return jsonSyntaxError("comma or closing bracket", i)
+		}
+	}
+}
+
+// skipJSONValue returns the end of the JSON value starting at i. Nested values
+// are only checked to be balanced, encoding/json validates them when decoded.
+func skipJSONValue(data []byte, i int) (int, error) {
+	if i == len(data) {
+		return 0, jsonSyntaxError("value", i)
+	}
+
+	switch c := data[i]; {
+	case c == '"':
+		end, _, err := scanJSONString(data, i)
+		return end, err
+
+	case c == '{' || c == '[':
+		depth := 0
+
+		for j := i; j < len(data); j++ {
+			switch data[j] {
+			case '"':
+				end, _, err := scanJSONString(data, j)
+				if err != nil {
+					return 0, err
+				}
+
+				j = end - 1
+
+			case '{', '[':
+				depth++
+
+			case '}', ']':
+				depth--
+				if depth == 0 {
+					return j + 1, nil
+				}
+			}
+		}
+
+		return 0, jsonSyntaxError("closing bracket", len(data))
+
+	case c == 't':
+		return skipJSONLiteral(data, i, "true")
+
+	case c == 'f':
+		return skipJSONLiteral(data, i, "false")
+
+	case c == 'n':
+		return skipJSONLiteral(data, i, "null")
+
+	case c == '-' || c >= '0' && c <= '9':
+		j := i + 1
+		for j < len(data) && isJSONNumberByte(data[j]) {
+			j++
+		}
+
+		return j, nil
+	}
+
+	return 0, jsonSyntaxError("value", i)
+}
+
+// scanJSONString returns the end of the JSON string starting at i and
+// whether it contains escape sequences.
+func scanJSONString(data []byte, i int) (end int, escaped bool, err error) {
+	for j := i + 1; j < len(data); j++ {
+		switch c := data[j]; {
+		case c == '"':
+			return j + 1, escaped, nil
+
+		case c == '\\':
+			escaped = true
+			j++
+
+		case c < 0x20:
+			return 0, false, jsonSyntaxError("string character", j)
+		}
+	}
+
+	return 0, false, jsonSyntaxError("closing quote", len(data))
+}
+
+func skipJSONLiteral(data []byte, i int, literal string) (int, error) {
+	if !bytes.HasPrefix(data[i:], []byte(literal)) {
+		return 0, jsonSyntaxError(literal, i)
+	}
+
+	return i + len(literal), nil
+}
+
+func isJSONNumberByte(c byte) bool {
+	return c >= '0' && c <= '9' || c == '.' || c == 'e' || c == 'E' || c == '+' || c == '-'
+}
+
+func skipJSONSpace(data []byte, i int) int {
+	for i < len(data) {
+		switch data[i] {
+		case ' ', '\t', '\n', '\r':
+			i++
+
+		default:
+			return i
+		}
+	}
+
+	return i
+}
+
+func checkJSONEnd(data []byte, i int) error {
+	if i = skipJSONSpace(data, i); i != len(data) {
+		return jsonSyntaxError("end of input", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,303 @@
+package gogram_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/darxnet/gogram"
+)
+
+const testMessageJSON = `{
+		"message_id": 42,
+		"date": 1700000000,
+		"from": {"id": 7, "is_bot": false, "first_name": "Ann \"A\" \u00e9", "language_code": "en"},
+		"chat": {"id": -1001234567890, "type": "supergroup", "title": "<chat> & co", "is_forum": true},
+		"text": "/start line\nbreak \u2028 \ud83d\ude00",
+		"entities": [{"type": "bot_command", "offset": 0, "length": 6}, {"type": "text_link", "offset": 7, "length": 4, "url": "https://example.com"}],
+		"reply_to_message": {"message_id": 41, "date": 1699999999, "chat": {"id": -1001234567890, "type": "supergroup"}, "text": "hi"},
+		"location": {"latitude": 51.5, "longitude": -0.125},
+		"reply_markup": {"inline_keyboard": [[{"text": "ok", "callback_data": "ok"}]]},
+		"unknown_field": {"nested": [1, "}", null]}
+	}`
+
+func TestSendMessageParams_MarshalJSON_MatchesEncodingJSON(t *testing.T) {
+	t.Parallel()
+
+	type plainParams gogram.SendMessageParams
+
+	params := &gogram.SendMessageParams{
+		ChatID:          "-1001234567890",
+		MessageThreadID: -5,
+		Text:            "quote \" slash \\ <b>&amp;</b> \x01\b\f\n\r\t \u2028\u2029 invalid \xff \U0001F600",
+		ParseMode:       "HTML",
+		Entities:        []gogram.MessageEntity{{Type: "bold", Offset: 0, Length: 5}},
+		ProtectContent:  true,
+		ReplyMarkup: &gogram.ReplyMarkup{InlineKeyboardMarkup: &gogram.InlineKeyboardMarkup{
+			InlineKeyboard: [][]gogram.InlineKeyboardButton{{{Text: "ok", CallbackData: "ok"}}},
+		}},
+	}
+
+	for _, params := range []*gogram.SendMessageParams{params, {}} {
+		got := mustMarshal(t, params)
+		want := mustMarshal(t, (*plainParams)(params))
+
+		if !bytes.Equal(got, want) {
+			t.Errorf("MarshalJSON mismatch:\ngot  %s\nwant %s", got, want)
+		}
+	}
+}
+
+func TestMessage_UnmarshalJSON_MatchesEncodingJSON(t *testing.T) {
+	t.Parallel()
+
+	// Only the fields of Message itself are decoded with reflection, nested
+	// types still use their generated UnmarshalJSON.
+	type plainMessage gogram.Message
+
+	inputs := []string{
+		testMessageJSON,
+		`{"message_id": 9223372036854775807, "date": -12, "text": "q\u0031", "caption": "` + "\xff" + `",
+			"has_protected_content": false, "is_topic_message": true, "chat": null, "from": null, "photo": []}`,
+		`{}`,
+		`null`,
+	}
+
+	for _, input := range inputs {
+		var got gogram.Message
+		if err := json.Unmarshal([]byte(input), &got); err != nil {
+			t.Fatalf("Unmarshal %.40s: %v", input, err)
+		}
+
+		var want plainMessage
+		if err := json.Unmarshal([]byte(input), &want); err != nil {
+			t.Fatalf("Unmarshal plain %.40s: %v", input, err)
+		}
+
+		if !reflect.DeepEqual(got, gogram.Message(want)) {
+			t.Errorf("UnmarshalJSON mismatch for %.40s:\ngot  %+v\nwant %+v", input, got, want)
+		}
+	}
+}
+
+func TestUpdate_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var update gogram.Update
+
+	err := json.Unmarshal([]byte(`{"update_id": 1, "message": {"message_id": 3, "chat": null,
+		"entities": [], "photo": null, "from": null, "reply_to_message": `+testMessageJSON+`}}`), &update)
+	if err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	msg := update.Message
+	if msg == nil || msg.MessageID != 3 || msg.From != nil || msg.Photo != nil {
+		t.Fatalf("unexpected message: %+v", msg)
+	}
+
+	if msg.Entities == nil || len(msg.Entities) != 0 {
+		t.Errorf("entities = %#v, want an empty slice", msg.Entities)
+	}
+
+	reply := msg.ReplyToMessage
+	if reply == nil || reply.Chat.Title != "<chat> & co" || len(reply.Entities) != 2 {
+		t.Fatalf("unexpected reply: %+v", reply)
+	}
+
+	if reply.Entities[1].URL != "https://example.com" {
+		t.Errorf("unexpected entities: %+v", reply.Entities)
+	}
+
+	if reply.Location == nil || reply.Location.Longitude != -0.125 {
+		t.Errorf("unexpected location: %+v", reply.Location)
+	}
+
+	if markup := reply.ReplyMarkup; markup == nil || markup.InlineKeyboard[0][0].CallbackData != "ok" {
+		t.Errorf("unexpected reply markup: %+v", markup)
+	}
+}
+
+func TestMessage_UnmarshalJSON_Errors(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{
+		`{"message_id": "42"}`,
+		`{"message_id": 1.5}`,
+		`{"message_id": 99999999999999999999}`,
+		`{"text": 1}`,
+		`{"chat": []}`,
+		`{"entities": {}}`,
+		`{"entities": [1]}`,
+	} {
+		var msg gogram.Message
+		if err := json.Unmarshal([]byte(input), &msg); err == nil {
+			t.Errorf("Unmarshal %s: expected an error", input)
+		}
+	}
+}
+
+func TestMessage_UnmarshalJSON_Reuse(t *testing.T) {
+	t.Parallel()
+
+	var msg gogram.Message
+	if err := json.Unmarshal([]byte(testMessageJSON), &msg); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	from := msg.From
+
+	if err := json.Unmarshal([]byte(`{"from": {"last_name": "B"}, "reply_to_message": null}`), &msg); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if msg.From != from || msg.From.FirstName != `Ann "A" é` || msg.From.LastName != "B" {
+		t.Errorf("from was not decoded in place: %+v", msg.From)
+	}
+
+	if msg.ReplyToMessage != nil || msg.MessageID != 42 {
+		t.Errorf("unexpected message: %+v", msg)
+	}
+}
+
+func TestClient_SendMessage_JSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	var body []byte
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost("example.invalid"),
+		gogram.WithHTTPClient(&http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				body, _ = io.ReadAll(req.Body)
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     make(http.Header),
+					Body: io.NopCloser(strings.NewReader(
+						`{"result": {"message_id": 42, "text": "} ] \" {"}, "ok": true, "extra": [{}]}`,
+					)),
+				}, nil
+			}),
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	msg, err := client.SendMessage(t.Context(), &gogram.SendMessageParams{ChatID: "1", Text: "hi"})
+	if err != nil {
+		t.Fatalf("SendMessage: %v", err)
+	}
+
+	if got, want := string(body), `{"chat_id":"1","text":"hi"}`; got != want {
+		t.Errorf("request body = %s, want %s", got, want)
+	}
+
+	if msg.MessageID != 42 || msg.Text != `} ] " {` {
+		t.Errorf("unexpected message: %+v", msg)
+	}
+}
+
+func TestClient_SendMessage_BodyReadAfterReturn(t *testing.T) {
+	t.Parallel()
+
+	var late io.ReadCloser
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost("example.invalid"),
+		gogram.WithHTTPClient(&http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				if late == nil {
+					// Answer before reading the body, like a Transport
+					// receiving an early error response.
+					late = req.Body
+				} else {
+					_, _ = io.Copy(io.Discard, req.Body)
+					_ = req.Body.Close()
+				}
+
+				return jsonHTTPResponse(t, &gogram.Response{OK: true, Result: json.RawMessage(`{"message_id":1}`)}), nil
+			}),
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	first := strings.Repeat("a", 1000)
+
+	for _, text := range []string{first, strings.Repeat("b", 1000)} {
+		if _, err = client.SendMessage(t.Context(), &gogram.SendMessageParams{ChatID: "1", Text: text}); err != nil {
+			t.Fatalf("SendMessage: %v", err)
+		}
+	}
+
+	body, _ := io.ReadAll(late)
+	_ = late.Close()
+
+	if want := `{"chat_id":"1","text":"` + first + `"}`; string(body) != want {
+		t.Errorf("body read after return = %.50s..., want %.50s...", body, want)
+	}
+}
+
+func BenchmarkClient_SendMessage(b *testing.B) {
+	response := []byte(`{"ok":true,"result":` + testMessageJSON + `}`)
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost("example.invalid"),
+		gogram.WithHTTPClient(&http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				_, _ = io.Copy(io.Discard, req.Body)
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     make(http.Header),
+					Body:       io.NopCloser(bytes.NewReader(response)),
+				}, nil
+			}),
+		}),
+	)
+	if err != nil {
+		b.Fatalf("NewClient: %v", err)
+	}
+
+	ctx := gogram.ContextWithoutRateLimit(b.Context())
+	params := &gogram.SendMessageParams{ChatID: "-1001234567890", Text: "hello", ParseMode: "HTML"}
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		if _, err := client.SendMessage(ctx, params); err != nil {
+			b.Fatalf("SendMessage: %v", err)
+		}
+	}
+}
+
+func BenchmarkSendMessageParams_MarshalJSON(b *testing.B) {
+	params := &gogram.SendMessageParams{ChatID: "-1001234567890", Text: "hello <world>", ParseMode: "HTML"}
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		if _, err := params.MarshalJSON(); err != nil {
+			b.Fatalf("MarshalJSON: %v", err)
+		}
+	}
+}
+
+func BenchmarkUpdate_UnmarshalJSON(b *testing.B) {
+	data := []byte(`{"update_id": 1, "message": ` + testMessageJSON + `}`)
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		var update gogram.Update
+		if err := json.Unmarshal(data, &update); err != nil {
+			b.Fatalf("Unmarshal: %v", err)
+		}
+	}
+}
@@ -15,6 +15,15 @@ const (
 	CaptionMaxLen = 1024
 	// MediaGroupMaxLen is the maximum number of media items in an album.
 	MediaGroupMaxLen = 10
+
+	// UploadMaxSize is the maximum size of a file uploaded to the Bot API
+	// server.
+	UploadMaxSize = 50 << 20
+	// LocalUploadMaxSize is the maximum size of a file uploaded to a local
+	// Bot API server.
+	LocalUploadMaxSize = 2000 << 20
+	// PhotoMaxSize is the maximum size of a photo uploaded with sendPhoto.
+	PhotoMaxSize = 10 << 20
 )
 
 // StartParamRegexp is a regular expression for validating start parameters.
@@ -0,0 +1,70 @@
+package gogram
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+)
+
+// WithLogger sets the logger for polling errors, retries, [UpdateStore]
+// failures, webhook rejections, shutdown, and for handler errors and panics
+// when the router has no error or panic handler. By default it is [slog.Default]; pass a
+// logger with [slog.DiscardHandler] to disable logging.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) {
+		c.cfg.logger = logger
+	}
+}
+
+// logger returns the client logger.
+func (c *Client) logger() *slog.Logger {
+	if c == nil || c.cfg.logger == nil {
+		return slog.Default()
+	}
+
+	return c.cfg.logger
+}
+
+// log writes a record with the update attributes of ctx, if any.
+func (c *Client) log(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr) {
+	logger := c.logger()
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+
+	if gogramCtx, ok := ctx.(*Context); ok {
+		attrs = append(attrs, gogramCtx.logAttrs()...)
+	}
+
+	logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// logPanic logs a recovered panic with the stack of the panicking goroutine.
+// It must be called from the deferred function that recovered v.
+func (c *Client) logPanic(ctx *Context, v any) {
+	c.log(ctx, slog.LevelError, "gogram: recovered panic",
+		slog.Any("panic", v),
+		slog.String("stack", string(debug.Stack())),
+	)
+}
+
+// logAttrs returns the attributes identifying the update of ctx.
+func (ctx *Context) logAttrs() []slog.Attr {
+	if ctx.update == nil {
+		return nil
+	}
+
+	attrs := []slog.Attr{slog.Int64("update_id", ctx.update.UpdateID)}
+
+	if on := ctx.findHandlerOn(); on != 0 {
+		attrs = append(attrs, slog.String("update_kind", handleOnNames[on]))
+	}
+	if chat := ctx.Chat(); chat != nil {
+		attrs = append(attrs, slog.Int64("chat_id", chat.ID))
+	}
+	if user := ctx.User(); user != nil {
+		attrs = append(attrs, slog.Int64("user_id", user.ID))
+	}
+
+	return attrs
+}
@@ -0,0 +1,180 @@
+package gogram_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/darxnet/gogram"
+)
+
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestWithLogger_PanicWithUpdateAttributes(t *testing.T) {
+	t.Parallel()
+
+	router := gogram.NewRouter()
+	router.HandleOnMessage(func(*gogram.Context, *gogram.Message) error {
+		panic("boom")
+	})
+
+	var out syncBuffer
+	client, err := gogram.NewClient(testToken,
+		gogram.WithRouter(router),
+		gogram.WithLogger(slog.New(slog.NewJSONHandler(&out, nil))),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	deliveries := make(chan gogram.Delivery, 1)
+	deliveries <- gogram.Delivery{Update: &gogram.Update{
+		UpdateID: 3,
+		Message:  &gogram.Message{Chat: gogram.Chat{ID: 10}, From: &gogram.User{ID: 20}},
+	}}
+	close(deliveries)
+
+	if err = client.Run(t.Context(), gogram.ChannelSource(deliveries)); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	var record map[string]any
+	for line := range strings.Lines(out.String()) {
+		var r map[string]any
+		if err = json.Unmarshal([]byte(line), &r); err != nil {
+			t.Fatalf("unmarshal %q: %v", line, err)
+		}
+		if r["msg"] == "gogram: recovered panic" {
+			record = r
+		}
+	}
+
+	if record == nil {
+		t.Fatalf("panic was not logged:\n%s", out.String())
+	}
+	if record["level"] != "ERROR" || record["panic"] != "boom" {
+		t.Errorf("unexpected record %v", record)
+	}
+	for key, want := range map[string]any{"update_id": 3.0, "chat_id": 10.0, "user_id": 20.0, "update_kind": "message"} {
+		if record[key] != want {
+			t.Errorf("%s = %v, want %v", key, record[key], want)
+		}
+	}
+	if stack, _ := record["stack"].(string); !strings.Contains(stack, "logging_test.go") {
+		t.Errorf("stack does not point to the handler:\n%s", stack)
+	}
+}
+
+func TestWithLogger_UpdateTypeNotAllowed(t *testing.T) {
+	t.Parallel()
+
+	router := gogram.NewRouter()
+	router.HandleOnMessage(func(*gogram.Context, *gogram.Message) error { return nil })
+	router.HandleOnChatMember(func(*gogram.Context, *gogram.ChatMemberUpdated) error { return nil })
+
+	var out syncBuffer
+	client, err := gogram.NewClient(testToken,
+		gogram.WithRouter(router),
+		gogram.WithLogger(slog.New(slog.NewJSONHandler(&out, nil))),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	// Without an error handler the excluded handlers are only reported in the log.
+	startTestWebhook(t, client, &gogram.SetWebhookParams{
+		URL:            "https://example.invalid/hook",
+		AllowedUpdates: []string{"message"},
+	})
+
+	for line := range strings.Lines(out.String()) {
+		var r map[string]any
+		if err = json.Unmarshal([]byte(line), &r); err != nil {
+			t.Fatalf("unmarshal %q: %v", line, err)
+		}
+		if r["msg"] == "gogram: handlers will not be called" {
+			if r["level"] != "WARN" || !strings.Contains(r["error"].(string), "chat_member") {
+				t.Errorf("unexpected record %v", r)
+			}
+			return
+		}
+	}
+
+	t.Fatalf("excluded update types were not logged:\n%s", out.String())
+}
+
+type failingUpdateStore struct{ gogram.UpdateStore }
+
+func (failingUpdateStore) MarkSeen(context.Context, int64, int64) (bool, error) {
+	return false, errors.New("store unavailable")
+}
+
+func TestWithLogger_UpdateStoreError(t *testing.T) {
+	t.Parallel()
+
+	var handled atomic.Int32
+
+	router := gogram.NewRouter()
+	router.HandleOnMessage(func(*gogram.Context, *gogram.Message) error {
+		handled.Add(1)
+		return nil
+	})
+
+	var out syncBuffer
+	client, err := gogram.NewClient(testToken,
+		gogram.WithRouter(router),
+		gogram.WithUpdateStore(failingUpdateStore{}),
+		gogram.WithLogger(slog.New(slog.NewJSONHandler(&out, nil))),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	deliveries := make(chan gogram.Delivery, 1)
+	deliveries <- gogram.Delivery{Update: &gogram.Update{UpdateID: 1, Message: &gogram.Message{}}}
+	close(deliveries)
+
+	if err = client.Run(t.Context(), gogram.ChannelSource(deliveries)); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if handled.Load() != 1 {
+		t.Error("update was not handled after the store failed")
+	}
+
+	for line := range strings.Lines(out.String()) {
+		var r map[string]any
+		if err = json.Unmarshal([]byte(line), &r); err != nil {
+			t.Fatalf("unmarshal %q: %v", line, err)
+		}
+		if r["msg"] == "gogram: marking update as seen failed" {
+			if r["level"] != "ERROR" || r["error"] != "store unavailable" {
+				t.Errorf("unexpected record %v", r)
+			}
+			return
+		}
+	}
+
+	t.Fatalf("store failure was not logged:\n%s", out.String())
+}
@@ -3,11 +3,10 @@
 package gogram
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
-	"mime/multipart"
 	"strconv"
 )
 
@@ -70,85 +69,74 @@ func WithAddStickerToSetSticker(value InputSticker) AddStickerToSetOption {
 // Other sticker sets can have up to 120 stickers.
 // Returns True on success.
 func (c *Client) AddStickerToSet(ctx context.Context, params *AddStickerToSetParams) (ret bool, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
-	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
+	writer := c.newMultipartForm(ctx, "addStickerToSet")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
 
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	contentType := writer.FormDataContentType()
 
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	{
+		v := strconv.FormatInt(params.UserID, 10)
+		err = writer.WriteField("user_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-			writeErr <- pw.Close()
-		}()
+	{
+		v := params.Name
+		err = writer.WriteField("name", v)
+		if err != nil {
+			return
+		}
+	}
 
-		{
-			v := strconv.FormatInt(params.UserID, 10)
-			err = writer.WriteField("user_id", v)
-			if err != nil {
-				return
-			}
+	{
+		err = createFormFileFromInputFile(
+			writer,
+			&params.Sticker.Sticker,
+			"sticker__sticker",
+		)
+		if err != nil {
+			return
 		}
 
-		{
-			v := params.Name
-			err = writer.WriteField("name", v)
-			if err != nil {
-				return
-			}
+		var bs []byte
+		bs, err = json.Marshal(&params.Sticker)
+		if err != nil {
+			return
 		}
 
-		{
-			err = createFormFileFromInputFile(
-				writer,
-				&params.Sticker.Sticker,
-				"sticker__sticker",
-			)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("sticker")
+		if err != nil {
+			return
+		}
 
-			var bs []byte
-			bs, err = json.Marshal(&params.Sticker)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("sticker")
-			if err != nil {
-				return
-			}
+	if err = writer.finish(); err != nil {
+		return
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
-		}
+	reader := writer.body()
 
-	}()
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "addStickerToSet", reader, contentType, nil)
+	result, err = c.call(ctx, "addStickerToSet", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -266,19 +254,31 @@ func WithAnswerCallbackQueryCacheTime(value int64) AnswerCallbackQueryOption {
 //
 // [inline keyboards]: https://core.telegram.org/bots/features#inline-keyboards
 func (c *Client) AnswerCallbackQuery(ctx context.Context, params *AnswerCallbackQueryParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "answerCallbackQuery", reader, contentType, nil)
+	result, err = c.call(ctx, "answerCallbackQuery", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -337,19 +337,31 @@ func WithAnswerChatJoinRequestQueryResult(value string) AnswerChatJoinRequestQue
 // Use this method to process a received chat join request query.
 // Returns True on success.
 func (c *Client) AnswerChatJoinRequestQuery(ctx context.Context, params *AnswerChatJoinRequestQueryParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "answerChatJoinRequestQuery", reader, contentType, nil)
+	result, err = c.call(ctx, "answerChatJoinRequestQuery", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -408,19 +420,31 @@ func WithAnswerGuestQueryResult(value InlineQueryResult) AnswerGuestQueryOption
 //
 // [SentGuestMessage]: https://core.telegram.org/bots/api#sentguestmessage
 func (c *Client) AnswerGuestQuery(ctx context.Context, params *AnswerGuestQueryParams) (ret *SentGuestMessage, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "answerGuestQuery", reader, contentType, nil)
+	result, err = c.call(ctx, "answerGuestQuery", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -535,19 +559,31 @@ func WithAnswerInlineQueryButton(value *InlineQueryResultsButton) AnswerInlineQu
 // On success, True is returned.
 // No more than 50 results per query are allowed.
 func (c *Client) AnswerInlineQuery(ctx context.Context, params *AnswerInlineQueryParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "answerInlineQuery", reader, contentType, nil)
+	result, err = c.call(ctx, "answerInlineQuery", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -630,19 +666,31 @@ func WithAnswerPreCheckoutQueryErrorMessage(value string) AnswerPreCheckoutQuery
 //
 // [Update]: https://core.telegram.org/bots/api#update
 func (c *Client) AnswerPreCheckoutQuery(ctx context.Context, params *AnswerPreCheckoutQueryParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "answerPreCheckoutQuery", reader, contentType, nil)
+	result, err = c.call(ctx, "answerPreCheckoutQuery", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -734,19 +782,31 @@ func WithAnswerShippingQueryErrorMessage(value string) AnswerShippingQueryOption
 //
 // [Update]: https://core.telegram.org/bots/api#update
 func (c *Client) AnswerShippingQuery(ctx context.Context, params *AnswerShippingQueryParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "answerShippingQuery", reader, contentType, nil)
+	result, err = c.call(ctx, "answerShippingQuery", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -806,19 +866,31 @@ func WithAnswerWebAppQueryResult(value InlineQueryResult) AnswerWebAppQueryOptio
 // [Web App]: https://core.telegram.org/bots/webapps
 // [SentWebAppMessage]: https://core.telegram.org/bots/api#sentwebappmessage
 func (c *Client) AnswerWebAppQuery(ctx context.Context, params *AnswerWebAppQueryParams) (ret *SentWebAppMessage, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "answerWebAppQuery", reader, contentType, nil)
+	result, err = c.call(ctx, "answerWebAppQuery", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -877,19 +949,31 @@ func WithApproveChatJoinRequestUserID(value int64) ApproveChatJoinRequestOption
 // The bot must be an administrator in the chat for this to work and must have the can_invite_users administrator right.
 // Returns True on success.
 func (c *Client) ApproveChatJoinRequest(ctx context.Context, params *ApproveChatJoinRequestParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "approveChatJoinRequest", reader, contentType, nil)
+	result, err = c.call(ctx, "approveChatJoinRequest", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -961,19 +1045,31 @@ func WithApproveSuggestedPostSendDate(value int64) ApproveSuggestedPostOption {
 // The bot must have the 'can_post_messages' administrator right in the corresponding channel chat.
 // Returns True on success.
 func (c *Client) ApproveSuggestedPost(ctx context.Context, params *ApproveSuggestedPostParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "approveSuggestedPost", reader, contentType, nil)
+	result, err = c.call(ctx, "approveSuggestedPost", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -1066,19 +1162,31 @@ func WithBanChatMemberRevokeMessages(value bool) BanChatMemberOption {
 //
 // [unbanned]: https://core.telegram.org/bots/api#unbanchatmember
 func (c *Client) BanChatMember(ctx context.Context, params *BanChatMemberParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "banChatMember", reader, contentType, nil)
+	result, err = c.call(ctx, "banChatMember", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -1139,19 +1247,31 @@ func WithBanChatSenderChatSenderChatID(value int64) BanChatSenderChatOption {
 //
 // [unbanned]: https://core.telegram.org/bots/api#unbanchatsenderchat
 func (c *Client) BanChatSenderChat(ctx context.Context, params *BanChatSenderChatParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "banChatSenderChat", reader, contentType, nil)
+	result, err = c.call(ctx, "banChatSenderChat", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -1188,19 +1308,31 @@ func (r *CloseParams) Option(opts ...CloseOption) (previous CloseOption) {
 // Returns True on success.
 // Requires no parameters.
 func (c *Client) Close(ctx context.Context, params *CloseParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "close", reader, contentType, nil)
+	result, err = c.call(ctx, "close", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -1258,19 +1390,31 @@ func WithCloseForumTopicMessageThreadID(value int64) CloseForumTopicOption {
 // The bot must be an administrator in the chat for this to work and must have the can_manage_topics administrator rights, unless it is the creator of the topic.
 // Returns True on success.
 func (c *Client) CloseForumTopic(ctx context.Context, params *CloseForumTopicParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "closeForumTopic", reader, contentType, nil)
+	result, err = c.call(ctx, "closeForumTopic", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -1316,19 +1460,31 @@ func WithCloseGeneralForumTopicChatID(value string) CloseGeneralForumTopicOption
 // The bot must be an administrator in the chat for this to work and must have the can_manage_topics administrator rights.
 // Returns True on success.
 func (c *Client) CloseGeneralForumTopic(ctx context.Context, params *CloseGeneralForumTopicParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "closeGeneralForumTopic", reader, contentType, nil)
+	result, err = c.call(ctx, "closeGeneralForumTopic", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -1386,19 +1542,31 @@ func WithConvertGiftToStarsOwnedGiftID(value string) ConvertGiftToStarsOption {
 // Requires the can_convert_gifts_to_stars business bot right.
 // Returns True on success.
 func (c *Client) ConvertGiftToStars(ctx context.Context, params *ConvertGiftToStarsParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "convertGiftToStars", reader, contentType, nil)
+	result, err = c.call(ctx, "convertGiftToStars", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -1674,19 +1842,31 @@ func WithCopyMessageReplyMarkup(value *ReplyMarkup) CopyMessageOption {
 // [forwardMessage]: https://core.telegram.org/bots/api#forwardmessage
 // [MessageId]: https://core.telegram.org/bots/api#messageid
 func (c *Client) CopyMessage(ctx context.Context, params *CopyMessageParams) (ret *MessageId, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "copyMessage", reader, contentType, nil)
+	result, err = c.call(ctx, "copyMessage", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -1833,19 +2013,31 @@ func WithCopyMessagesRemoveCaption(value bool) CopyMessagesOption {
 // [forwardMessages]: https://core.telegram.org/bots/api#forwardmessages
 // [MessageId]: https://core.telegram.org/bots/api#messageid
 func (c *Client) CopyMessages(ctx context.Context, params *CopyMessagesParams) (ret []MessageId, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "copyMessages", reader, contentType, nil)
+	result, err = c.call(ctx, "copyMessages", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -1946,19 +2138,31 @@ func WithCreateChatInviteLinkCreatesJoinRequest(value bool) CreateChatInviteLink
 // [revokeChatInviteLink]: https://core.telegram.org/bots/api#revokechatinvitelink
 // [ChatInviteLink]: https://core.telegram.org/bots/api#chatinvitelink
 func (c *Client) CreateChatInviteLink(ctx context.Context, params *CreateChatInviteLinkParams) (ret *ChatInviteLink, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "createChatInviteLink", reader, contentType, nil)
+	result, err = c.call(ctx, "createChatInviteLink", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -2049,19 +2253,31 @@ func WithCreateChatSubscriptionInviteLinkSubscriptionPrice(value int64) CreateCh
 // [revokeChatInviteLink]: https://core.telegram.org/bots/api#revokechatinvitelink
 // [ChatInviteLink]: https://core.telegram.org/bots/api#chatinvitelink
 func (c *Client) CreateChatSubscriptionInviteLink(ctx context.Context, params *CreateChatSubscriptionInviteLinkParams) (ret *ChatInviteLink, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "createChatSubscriptionInviteLink", reader, contentType, nil)
+	result, err = c.call(ctx, "createChatSubscriptionInviteLink", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -2154,19 +2370,31 @@ func WithCreateForumTopicIconCustomEmojiID(value string) CreateForumTopicOption
 //
 // [ForumTopic]: https://core.telegram.org/bots/api#forumtopic
 func (c *Client) CreateForumTopic(ctx context.Context, params *CreateForumTopicParams) (ret *ForumTopic, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "createForumTopic", reader, contentType, nil)
+	result, err = c.call(ctx, "createForumTopic", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -2568,19 +2796,31 @@ func WithCreateInvoiceLinkIsFlexible(value bool) CreateInvoiceLinkOption {
 // Use this method to create a link for an invoice.
 // Returns the created invoice link as String on success.
 func (c *Client) CreateInvoiceLink(ctx context.Context, params *CreateInvoiceLinkParams) (ret string, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "createInvoiceLink", reader, contentType, nil)
+	result, err = c.call(ctx, "createInvoiceLink", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -2696,111 +2936,100 @@ func WithCreateNewStickerSetNeedsRepainting(value bool) CreateNewStickerSetOptio
 // The bot will be able to edit the sticker set thus created.
 // Returns True on success.
 func (c *Client) CreateNewStickerSet(ctx context.Context, params *CreateNewStickerSetParams) (ret bool, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
-	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
-
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	writer := c.newMultipartForm(ctx, "createNewStickerSet")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
 
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	contentType := writer.FormDataContentType()
 
-			writeErr <- pw.Close()
-		}()
+	{
+		v := strconv.FormatInt(params.UserID, 10)
+		err = writer.WriteField("user_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-		{
-			v := strconv.FormatInt(params.UserID, 10)
-			err = writer.WriteField("user_id", v)
-			if err != nil {
-				return
-			}
+	{
+		v := params.Name
+		err = writer.WriteField("name", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := params.Name
-			err = writer.WriteField("name", v)
-			if err != nil {
-				return
-			}
+	{
+		v := params.Title
+		err = writer.WriteField("title", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := params.Title
-			err = writer.WriteField("title", v)
+	{
+		for i := range params.Stickers {
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Stickers[i].Sticker,
+				"stickers__sticker"+strconv.Itoa(i),
+			)
 			if err != nil {
 				return
 			}
 		}
 
-		{
-			for i := range params.Stickers {
-				err = createFormFileFromInputFile(
-					writer,
-					&params.Stickers[i].Sticker,
-					"stickers__sticker"+strconv.Itoa(i),
-				)
-				if err != nil {
-					return
-				}
-			}
-
-			var bs []byte
-			bs, err = json.Marshal(&params.Stickers)
-			if err != nil {
-				return
-			}
+		var bs []byte
+		bs, err = json.Marshal(&params.Stickers)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("stickers")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("stickers")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.StickerType != "" {
-			v := params.StickerType
-			err = writer.WriteField("sticker_type", v)
-			if err != nil {
-				return
-			}
+	if params.StickerType != "" {
+		v := params.StickerType
+		err = writer.WriteField("sticker_type", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.NeedsRepainting {
-			v := strconv.FormatBool(params.NeedsRepainting)
-			err = writer.WriteField("needs_repainting", v)
-			if err != nil {
-				return
-			}
+	if params.NeedsRepainting {
+		v := strconv.FormatBool(params.NeedsRepainting)
+		err = writer.WriteField("needs_repainting", v)
+		if err != nil {
+			return
 		}
+	}
+
+	if err = writer.finish(); err != nil {
+		return
+	}
 
-	}()
+	reader := writer.body()
+
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "createNewStickerSet", reader, contentType, nil)
+	result, err = c.call(ctx, "createNewStickerSet", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -2858,19 +3087,31 @@ func WithDeclineChatJoinRequestUserID(value int64) DeclineChatJoinRequestOption
 // The bot must be an administrator in the chat for this to work and must have the can_invite_users administrator right.
 // Returns True on success.
 func (c *Client) DeclineChatJoinRequest(ctx context.Context, params *DeclineChatJoinRequestParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "declineChatJoinRequest", reader, contentType, nil)
+	result, err = c.call(ctx, "declineChatJoinRequest", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -2940,19 +3181,31 @@ func WithDeclineSuggestedPostComment(value string) DeclineSuggestedPostOption {
 // The bot must have the 'can_manage_direct_messages' administrator right in the corresponding channel chat.
 // Returns True on success.
 func (c *Client) DeclineSuggestedPost(ctx context.Context, params *DeclineSuggestedPostParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "declineSuggestedPost", reader, contentType, nil)
+	result, err = c.call(ctx, "declineSuggestedPost", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -3022,19 +3275,31 @@ func WithDeleteAllMessageReactionsActorChatID(value int64) DeleteAllMessageReact
 // The bot must have the 'can_delete_messages' administrator right in the chat.
 // Returns True on success.
 func (c *Client) DeleteAllMessageReactions(ctx context.Context, params *DeleteAllMessageReactionsParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "deleteAllMessageReactions", reader, contentType, nil)
+	result, err = c.call(ctx, "deleteAllMessageReactions", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -3100,19 +3365,31 @@ func WithDeleteBusinessMessagesMessageIDs(value []int64) DeleteBusinessMessagesO
 // Requires the can_delete_sent_messages business bot right to delete messages sent by the bot itself, or the can_delete_all_messages business bot right to delete any message.
 // Returns True on success.
 func (c *Client) DeleteBusinessMessages(ctx context.Context, params *DeleteBusinessMessagesParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "deleteBusinessMessages", reader, contentType, nil)
+	result, err = c.call(ctx, "deleteBusinessMessages", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -3159,19 +3436,31 @@ func WithDeleteChatPhotoChatID(value string) DeleteChatPhotoOption {
 // The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
 // Returns True on success.
 func (c *Client) DeleteChatPhoto(ctx context.Context, params *DeleteChatPhotoParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "deleteChatPhoto", reader, contentType, nil)
+	result, err = c.call(ctx, "deleteChatPhoto", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -3220,19 +3509,31 @@ func WithDeleteChatStickerSetChatID(value string) DeleteChatStickerSetOption {
 //
 // [getChat]: https://core.telegram.org/bots/api#getchat
 func (c *Client) DeleteChatStickerSet(ctx context.Context, params *DeleteChatStickerSetParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "deleteChatStickerSet", reader, contentType, nil)
+	result, err = c.call(ctx, "deleteChatStickerSet", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -3302,19 +3603,31 @@ func WithDeleteEphemeralMessageEphemeralMessageID(value int64) DeleteEphemeralMe
 // Note that it is not guaranteed that the user will receive the message deletion event, especially if they are offline.
 // Returns True on success.
 func (c *Client) DeleteEphemeralMessage(ctx context.Context, params *DeleteEphemeralMessageParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "deleteEphemeralMessage", reader, contentType, nil)
+	result, err = c.call(ctx, "deleteEphemeralMessage", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -3372,19 +3685,31 @@ func WithDeleteForumTopicMessageThreadID(value int64) DeleteForumTopicOption {
 // In the case of a supergroup chat the bot must be an administrator in the chat for this to work and must have the can_delete_messages administrator rights.
 // Returns True on success.
 func (c *Client) DeleteForumTopic(ctx context.Context, params *DeleteForumTopicParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "deleteForumTopic", reader, contentType, nil)
+	result, err = c.call(ctx, "deleteForumTopic", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -3450,19 +3775,31 @@ func WithDeleteMessageMessageID(value int64) DeleteMessageOption {
 // - If the bot has can_manage_direct_messages administrator right in a channel, it can delete any message in the corresponding direct messages chat.
 // Returns True on success.
 func (c *Client) DeleteMessage(ctx context.Context, params *DeleteMessageParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "deleteMessage", reader, contentType, nil)
+	result, err = c.call(ctx, "deleteMessage", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -3544,19 +3881,31 @@ func WithDeleteMessageReactionActorChatID(value int64) DeleteMessageReactionOpti
 // The bot must have the 'can_delete_messages' administrator right in the chat.
 // Returns True on success.
 func (c *Client) DeleteMessageReaction(ctx context.Context, params *DeleteMessageReactionParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "deleteMessageReaction", reader, contentType, nil)
+	result, err = c.call(ctx, "deleteMessageReaction", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -3620,19 +3969,31 @@ func WithDeleteMessagesMessageIDs(value []int64) DeleteMessagesOption {
 // If some of the specified messages can't be found, they are skipped.
 // Returns True on success.
 func (c *Client) DeleteMessages(ctx context.Context, params *DeleteMessagesParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "deleteMessages", reader, contentType, nil)
+	result, err = c.call(ctx, "deleteMessages", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -3700,19 +4061,31 @@ func WithDeleteMyCommandsLanguageCode(value string) DeleteMyCommandsOption {
 //
 // [higher level commands]: https://core.telegram.org/bots/api#determining-list-of-commands
 func (c *Client) DeleteMyCommands(ctx context.Context, params *DeleteMyCommandsParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "deleteMyCommands", reader, contentType, nil)
+	result, err = c.call(ctx, "deleteMyCommands", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -3757,19 +4130,31 @@ func WithDeleteStickerFromSetSticker(value string) DeleteStickerFromSetOption {
 // Use this method to delete a sticker from a set created by the bot.
 // Returns True on success.
 func (c *Client) DeleteStickerFromSet(ctx context.Context, params *DeleteStickerFromSetParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "deleteStickerFromSet", reader, contentType, nil)
+	result, err = c.call(ctx, "deleteStickerFromSet", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -3814,19 +4199,31 @@ func WithDeleteStickerSetName(value string) DeleteStickerSetOption {
 // Use this method to delete a sticker set that was created by the bot.
 // Returns True on success.
 func (c *Client) DeleteStickerSet(ctx context.Context, params *DeleteStickerSetParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "deleteStickerSet", reader, contentType, nil)
+	result, err = c.call(ctx, "deleteStickerSet", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -3884,19 +4281,31 @@ func WithDeleteStoryStoryID(value int64) DeleteStoryOption {
 // Requires the can_manage_stories business bot right.
 // Returns True on success.
 func (c *Client) DeleteStory(ctx context.Context, params *DeleteStoryParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "deleteStory", reader, contentType, nil)
+	result, err = c.call(ctx, "deleteStory", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -3943,19 +4352,31 @@ func WithDeleteWebhookDropPendingUpdates(value bool) DeleteWebhookOption {
 //
 // [getUpdates]: https://core.telegram.org/bots/api#getupdates
 func (c *Client) DeleteWebhook(ctx context.Context, params *DeleteWebhookParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "deleteWebhook", reader, contentType, nil)
+	result, err = c.call(ctx, "deleteWebhook", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -4065,19 +4486,31 @@ func WithEditChatInviteLinkCreatesJoinRequest(value bool) EditChatInviteLinkOpti
 //
 // [ChatInviteLink]: https://core.telegram.org/bots/api#chatinvitelink
 func (c *Client) EditChatInviteLink(ctx context.Context, params *EditChatInviteLinkParams) (ret *ChatInviteLink, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "editChatInviteLink", reader, contentType, nil)
+	result, err = c.call(ctx, "editChatInviteLink", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -4150,19 +4583,31 @@ func WithEditChatSubscriptionInviteLinkName(value string) EditChatSubscriptionIn
 //
 // [ChatInviteLink]: https://core.telegram.org/bots/api#chatinvitelink
 func (c *Client) EditChatSubscriptionInviteLink(ctx context.Context, params *EditChatSubscriptionInviteLinkParams) (ret *ChatInviteLink, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "editChatSubscriptionInviteLink", reader, contentType, nil)
+	result, err = c.call(ctx, "editChatSubscriptionInviteLink", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -4291,19 +4736,31 @@ func WithEditEphemeralMessageCaptionReplyMarkup(value *InlineKeyboardMarkup) Edi
 // Note that it is not guaranteed that the user will receive the message edit event, especially if they are offline.
 // On success, True is returned.
 func (c *Client) EditEphemeralMessageCaption(ctx context.Context, params *EditEphemeralMessageCaptionParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "editEphemeralMessageCaption", reader, contentType, nil)
+	result, err = c.call(ctx, "editEphemeralMessageCaption", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -4403,218 +4860,207 @@ func WithEditEphemeralMessageMediaReplyMarkup(value *InlineKeyboardMarkup) EditE
 // Note that it is not guaranteed that the user will receive the message edit event, especially if they are offline.
 // On success, True is returned.
 func (c *Client) EditEphemeralMessageMedia(ctx context.Context, params *EditEphemeralMessageMediaParams) (ret bool, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
-	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
-
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	writer := c.newMultipartForm(ctx, "editEphemeralMessageMedia")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
 
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	contentType := writer.FormDataContentType()
 
-			writeErr <- pw.Close()
-		}()
+	{
+		v := params.ChatID
+		err = writer.WriteField("chat_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-		{
-			v := params.ChatID
-			err = writer.WriteField("chat_id", v)
-			if err != nil {
-				return
-			}
+	{
+		v := strconv.FormatInt(params.ReceiverUserID, 10)
+		err = writer.WriteField("receiver_user_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := strconv.FormatInt(params.ReceiverUserID, 10)
-			err = writer.WriteField("receiver_user_id", v)
-			if err != nil {
-				return
-			}
+	{
+		v := strconv.FormatInt(params.EphemeralMessageID, 10)
+		err = writer.WriteField("ephemeral_message_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := strconv.FormatInt(params.EphemeralMessageID, 10)
-			err = writer.WriteField("ephemeral_message_id", v)
+	{
+		switch {
+		case params.Media.InputMediaAnimation != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaAnimation.Media,
+				"media_animation_media",
+			)
 			if err != nil {
 				return
 			}
-		}
-
-		{
-			switch {
-			case params.Media.InputMediaAnimation != nil:
-				err = createFormFileFromInputFile(
-					writer,
-					&params.Media.InputMediaAnimation.Media,
-					"media_animation_media",
-				)
-				if err != nil {
-					return
-				}
-				if params.Media.InputMediaAnimation.Thumbnail != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.Media.InputMediaAnimation.Thumbnail,
-						"media_animation_thumbnail",
-					)
-					if err != nil {
-						return
-					}
-				}
-			case params.Media.InputMediaAudio != nil:
-				err = createFormFileFromInputFile(
-					writer,
-					&params.Media.InputMediaAudio.Media,
-					"media_audio_media",
-				)
-				if err != nil {
-					return
-				}
-				if params.Media.InputMediaAudio.Thumbnail != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.Media.InputMediaAudio.Thumbnail,
-						"media_audio_thumbnail",
-					)
-					if err != nil {
-						return
-					}
-				}
-			case params.Media.InputMediaDocument != nil:
+			if params.Media.InputMediaAnimation.Thumbnail != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.Media.InputMediaDocument.Media,
-					"media_document_media",
+					params.Media.InputMediaAnimation.Thumbnail,
+					"media_animation_thumbnail",
 				)
 				if err != nil {
 					return
 				}
-				if params.Media.InputMediaDocument.Thumbnail != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.Media.InputMediaDocument.Thumbnail,
-						"media_document_thumbnail",
-					)
-					if err != nil {
-						return
-					}
-				}
-			case params.Media.InputMediaLivePhoto != nil:
+			}
+		case params.Media.InputMediaAudio != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaAudio.Media,
+				"media_audio_media",
+			)
+			if err != nil {
+				return
+			}
+			if params.Media.InputMediaAudio.Thumbnail != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.Media.InputMediaLivePhoto.Media,
-					"media_live_photo_media",
+					params.Media.InputMediaAudio.Thumbnail,
+					"media_audio_thumbnail",
 				)
 				if err != nil {
 					return
 				}
+			}
+		case params.Media.InputMediaDocument != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaDocument.Media,
+				"media_document_media",
+			)
+			if err != nil {
+				return
+			}
+			if params.Media.InputMediaDocument.Thumbnail != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.Media.InputMediaLivePhoto.Photo,
-					"media_live_photo_photo",
+					params.Media.InputMediaDocument.Thumbnail,
+					"media_document_thumbnail",
 				)
 				if err != nil {
 					return
 				}
-			case params.Media.InputMediaPhoto != nil:
+			}
+		case params.Media.InputMediaLivePhoto != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaLivePhoto.Media,
+				"media_live_photo_media",
+			)
+			if err != nil {
+				return
+			}
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaLivePhoto.Photo,
+				"media_live_photo_photo",
+			)
+			if err != nil {
+				return
+			}
+		case params.Media.InputMediaPhoto != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaPhoto.Media,
+				"media_photo_media",
+			)
+			if err != nil {
+				return
+			}
+		case params.Media.InputMediaVideo != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaVideo.Media,
+				"media_video_media",
+			)
+			if err != nil {
+				return
+			}
+			if params.Media.InputMediaVideo.Thumbnail != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.Media.InputMediaPhoto.Media,
-					"media_photo_media",
+					params.Media.InputMediaVideo.Thumbnail,
+					"media_video_thumbnail",
 				)
 				if err != nil {
 					return
 				}
-			case params.Media.InputMediaVideo != nil:
+			}
+			if params.Media.InputMediaVideo.Cover != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.Media.InputMediaVideo.Media,
-					"media_video_media",
+					params.Media.InputMediaVideo.Cover,
+					"media_video_cover",
 				)
 				if err != nil {
 					return
 				}
-				if params.Media.InputMediaVideo.Thumbnail != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.Media.InputMediaVideo.Thumbnail,
-						"media_video_thumbnail",
-					)
-					if err != nil {
-						return
-					}
-				}
-				if params.Media.InputMediaVideo.Cover != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.Media.InputMediaVideo.Cover,
-						"media_video_cover",
-					)
-					if err != nil {
-						return
-					}
-				}
 			}
+		}
 
-			var bs []byte
-			bs, err = json.Marshal(&params.Media)
-			if err != nil {
-				return
-			}
+		var bs []byte
+		bs, err = json.Marshal(&params.Media)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("media")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("media")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReplyMarkup != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyMarkup)
-			if err != nil {
-				return
-			}
+	if params.ReplyMarkup != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyMarkup)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_markup")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_markup")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
+
+	if err = writer.finish(); err != nil {
+		return
+	}
 
-	}()
+	reader := writer.body()
+
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "editEphemeralMessageMedia", reader, contentType, nil)
+	result, err = c.call(ctx, "editEphemeralMessageMedia", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -4700,19 +5146,31 @@ func WithEditEphemeralMessageReplyMarkupReplyMarkup(value *InlineKeyboardMarkup)
 // Note that it is not guaranteed that the user will receive the message edit event, especially if they are offline.
 // On success, True is returned.
 func (c *Client) EditEphemeralMessageReplyMarkup(ctx context.Context, params *EditEphemeralMessageReplyMarkupParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "editEphemeralMessageReplyMarkup", reader, contentType, nil)
+	result, err = c.call(ctx, "editEphemeralMessageReplyMarkup", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -4852,19 +5310,31 @@ func WithEditEphemeralMessageTextReplyMarkup(value *InlineKeyboardMarkup) EditEp
 // Note that it is not guaranteed that the user will receive the message edit event, especially if they are offline.
 // On success, True is returned.
 func (c *Client) EditEphemeralMessageText(ctx context.Context, params *EditEphemeralMessageTextParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "editEphemeralMessageText", reader, contentType, nil)
+	result, err = c.call(ctx, "editEphemeralMessageText", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -4958,19 +5428,31 @@ func WithEditForumTopicIconCustomEmojiID(value string) EditForumTopicOption {
 // In the case of a supergroup chat the bot must be an administrator in the chat for this to work and must have the can_manage_topics administrator rights, unless it is the creator of the topic.
 // Returns True on success.
 func (c *Client) EditForumTopic(ctx context.Context, params *EditForumTopicParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "editForumTopic", reader, contentType, nil)
+	result, err = c.call(ctx, "editForumTopic", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -5028,19 +5510,31 @@ func WithEditGeneralForumTopicName(value string) EditGeneralForumTopicOption {
 // The bot must be an administrator in the chat for this to work and must have the can_manage_topics administrator rights.
 // Returns True on success.
 func (c *Client) EditGeneralForumTopic(ctx context.Context, params *EditGeneralForumTopicParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "editGeneralForumTopic", reader, contentType, nil)
+	result, err = c.call(ctx, "editGeneralForumTopic", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -5202,19 +5696,31 @@ func WithEditMessageCaptionReplyMarkup(value *InlineKeyboardMarkup) EditMessageC
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) EditMessageCaption(ctx context.Context, params *EditMessageCaptionParams) (ret *Message, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "editMessageCaption", reader, contentType, nil)
+	result, err = c.call(ctx, "editMessageCaption", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -5314,19 +5820,31 @@ func WithEditMessageChecklistReplyMarkup(value *InlineKeyboardMarkup) EditMessag
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) EditMessageChecklist(ctx context.Context, params *EditMessageChecklistParams) (ret *Message, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "editMessageChecklist", reader, contentType, nil)
+	result, err = c.call(ctx, "editMessageChecklist", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -5516,19 +6034,31 @@ func WithEditMessageLiveLocationReplyMarkup(value *InlineKeyboardMarkup) EditMes
 // [stopMessageLiveLocation]: https://core.telegram.org/bots/api#stopmessagelivelocation
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) EditMessageLiveLocation(ctx context.Context, params *EditMessageLiveLocationParams) (ret *Message, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "editMessageLiveLocation", reader, contentType, nil)
+	result, err = c.call(ctx, "editMessageLiveLocation", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -5649,226 +6179,215 @@ func WithEditMessageMediaReplyMarkup(value *InlineKeyboardMarkup) EditMessageMed
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) EditMessageMedia(ctx context.Context, params *EditMessageMediaParams) (ret *Message, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
-	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
-
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	writer := c.newMultipartForm(ctx, "editMessageMedia")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
 
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	contentType := writer.FormDataContentType()
 
-			writeErr <- pw.Close()
-		}()
+	if params.BusinessConnectionID != "" {
+		v := params.BusinessConnectionID
+		err = writer.WriteField("business_connection_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-		if params.BusinessConnectionID != "" {
-			v := params.BusinessConnectionID
-			err = writer.WriteField("business_connection_id", v)
-			if err != nil {
-				return
-			}
+	if params.ChatID != "" {
+		v := params.ChatID
+		err = writer.WriteField("chat_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ChatID != "" {
-			v := params.ChatID
-			err = writer.WriteField("chat_id", v)
-			if err != nil {
-				return
-			}
+	if params.MessageID != 0 {
+		v := strconv.FormatInt(params.MessageID, 10)
+		err = writer.WriteField("message_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageID != 0 {
-			v := strconv.FormatInt(params.MessageID, 10)
-			err = writer.WriteField("message_id", v)
-			if err != nil {
-				return
-			}
+	if params.InlineMessageID != "" {
+		v := params.InlineMessageID
+		err = writer.WriteField("inline_message_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.InlineMessageID != "" {
-			v := params.InlineMessageID
-			err = writer.WriteField("inline_message_id", v)
+	{
+		switch {
+		case params.Media.InputMediaAnimation != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaAnimation.Media,
+				"media_animation_media",
+			)
 			if err != nil {
 				return
 			}
-		}
-
-		{
-			switch {
-			case params.Media.InputMediaAnimation != nil:
+			if params.Media.InputMediaAnimation.Thumbnail != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.Media.InputMediaAnimation.Media,
-					"media_animation_media",
+					params.Media.InputMediaAnimation.Thumbnail,
+					"media_animation_thumbnail",
 				)
 				if err != nil {
 					return
 				}
-				if params.Media.InputMediaAnimation.Thumbnail != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.Media.InputMediaAnimation.Thumbnail,
-						"media_animation_thumbnail",
-					)
-					if err != nil {
-						return
-					}
-				}
-			case params.Media.InputMediaAudio != nil:
+			}
+		case params.Media.InputMediaAudio != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaAudio.Media,
+				"media_audio_media",
+			)
+			if err != nil {
+				return
+			}
+			if params.Media.InputMediaAudio.Thumbnail != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.Media.InputMediaAudio.Media,
-					"media_audio_media",
-				)
-				if err != nil {
-					return
-				}
-				if params.Media.InputMediaAudio.Thumbnail != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.Media.InputMediaAudio.Thumbnail,
-						"media_audio_thumbnail",
-					)
-					if err != nil {
-						return
-					}
-				}
-			case params.Media.InputMediaDocument != nil:
-				err = createFormFileFromInputFile(
-					writer,
-					&params.Media.InputMediaDocument.Media,
-					"media_document_media",
-				)
-				if err != nil {
-					return
-				}
-				if params.Media.InputMediaDocument.Thumbnail != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.Media.InputMediaDocument.Thumbnail,
-						"media_document_thumbnail",
-					)
-					if err != nil {
-						return
-					}
-				}
-			case params.Media.InputMediaLivePhoto != nil:
-				err = createFormFileFromInputFile(
-					writer,
-					&params.Media.InputMediaLivePhoto.Media,
-					"media_live_photo_media",
+					params.Media.InputMediaAudio.Thumbnail,
+					"media_audio_thumbnail",
 				)
 				if err != nil {
 					return
 				}
+			}
+		case params.Media.InputMediaDocument != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaDocument.Media,
+				"media_document_media",
+			)
+			if err != nil {
+				return
+			}
+			if params.Media.InputMediaDocument.Thumbnail != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.Media.InputMediaLivePhoto.Photo,
-					"media_live_photo_photo",
+					params.Media.InputMediaDocument.Thumbnail,
+					"media_document_thumbnail",
 				)
 				if err != nil {
 					return
 				}
-			case params.Media.InputMediaPhoto != nil:
+			}
+		case params.Media.InputMediaLivePhoto != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaLivePhoto.Media,
+				"media_live_photo_media",
+			)
+			if err != nil {
+				return
+			}
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaLivePhoto.Photo,
+				"media_live_photo_photo",
+			)
+			if err != nil {
+				return
+			}
+		case params.Media.InputMediaPhoto != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaPhoto.Media,
+				"media_photo_media",
+			)
+			if err != nil {
+				return
+			}
+		case params.Media.InputMediaVideo != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaVideo.Media,
+				"media_video_media",
+			)
+			if err != nil {
+				return
+			}
+			if params.Media.InputMediaVideo.Thumbnail != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.Media.InputMediaPhoto.Media,
-					"media_photo_media",
+					params.Media.InputMediaVideo.Thumbnail,
+					"media_video_thumbnail",
 				)
 				if err != nil {
 					return
 				}
-			case params.Media.InputMediaVideo != nil:
+			}
+			if params.Media.InputMediaVideo.Cover != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.Media.InputMediaVideo.Media,
-					"media_video_media",
+					params.Media.InputMediaVideo.Cover,
+					"media_video_cover",
 				)
 				if err != nil {
 					return
 				}
-				if params.Media.InputMediaVideo.Thumbnail != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.Media.InputMediaVideo.Thumbnail,
-						"media_video_thumbnail",
-					)
-					if err != nil {
-						return
-					}
-				}
-				if params.Media.InputMediaVideo.Cover != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.Media.InputMediaVideo.Cover,
-						"media_video_cover",
-					)
-					if err != nil {
-						return
-					}
-				}
 			}
+		}
 
-			var bs []byte
-			bs, err = json.Marshal(&params.Media)
-			if err != nil {
-				return
-			}
+		var bs []byte
+		bs, err = json.Marshal(&params.Media)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("media")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("media")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReplyMarkup != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyMarkup)
-			if err != nil {
-				return
-			}
+	if params.ReplyMarkup != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyMarkup)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_markup")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_markup")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-	}()
+	if err = writer.finish(); err != nil {
+		return
+	}
+
+	reader := writer.body()
+
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "editMessageMedia", reader, contentType, nil)
+	result, err = c.call(ctx, "editMessageMedia", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -5975,19 +6494,31 @@ func WithEditMessageReplyMarkupReplyMarkup(value *InlineKeyboardMarkup) EditMess
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) EditMessageReplyMarkup(ctx context.Context, params *EditMessageReplyMarkupParams) (ret *Message, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "editMessageReplyMarkup", reader, contentType, nil)
+	result, err = c.call(ctx, "editMessageReplyMarkup", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -6163,19 +6694,31 @@ func WithEditMessageTextReplyMarkup(value *InlineKeyboardMarkup) EditMessageText
 // [game]: https://core.telegram.org/bots/api#games
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) EditMessageText(ctx context.Context, params *EditMessageTextParams) (ret *Message, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "editMessageText", reader, contentType, nil)
+	result, err = c.call(ctx, "editMessageText", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -6302,151 +6845,140 @@ func WithEditStoryAreas(value []StoryArea) EditStoryOption {
 //
 // [Story]: https://core.telegram.org/bots/api#story
 func (c *Client) EditStory(ctx context.Context, params *EditStoryParams) (ret *Story, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
+	writer := c.newMultipartForm(ctx, "editStory")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
+
 	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
 
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	{
+		v := params.BusinessConnectionID
+		err = writer.WriteField("business_connection_id", v)
+		if err != nil {
+			return
+		}
+	}
+
+	{
+		v := strconv.FormatInt(params.StoryID, 10)
+		err = writer.WriteField("story_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-			err = writer.Close()
+	{
+		switch {
+		case params.Content.InputStoryContentPhoto != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Content.InputStoryContentPhoto.Photo,
+				"content_photo_photo",
+			)
 			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
 				return
 			}
-
-			writeErr <- pw.Close()
-		}()
-
-		{
-			v := params.BusinessConnectionID
-			err = writer.WriteField("business_connection_id", v)
+		case params.Content.InputStoryContentVideo != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Content.InputStoryContentVideo.Video,
+				"content_video_video",
+			)
 			if err != nil {
 				return
 			}
 		}
 
-		{
-			v := strconv.FormatInt(params.StoryID, 10)
-			err = writer.WriteField("story_id", v)
-			if err != nil {
-				return
-			}
+		var bs []byte
+		bs, err = json.Marshal(&params.Content)
+		if err != nil {
+			return
 		}
 
-		{
-			switch {
-			case params.Content.InputStoryContentPhoto != nil:
-				err = createFormFileFromInputFile(
-					writer,
-					&params.Content.InputStoryContentPhoto.Photo,
-					"content_photo_photo",
-				)
-				if err != nil {
-					return
-				}
-			case params.Content.InputStoryContentVideo != nil:
-				err = createFormFileFromInputFile(
-					writer,
-					&params.Content.InputStoryContentVideo.Video,
-					"content_video_video",
-				)
-				if err != nil {
-					return
-				}
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("content")
+		if err != nil {
+			return
+		}
 
-			var bs []byte
-			bs, err = json.Marshal(&params.Content)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("content")
-			if err != nil {
-				return
-			}
+	if params.Caption != "" {
+		v := params.Caption
+		err = writer.WriteField("caption", v)
+		if err != nil {
+			return
+		}
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+	if params.ParseMode != "" {
+		v := params.ParseMode
+		err = writer.WriteField("parse_mode", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Caption != "" {
-			v := params.Caption
-			err = writer.WriteField("caption", v)
-			if err != nil {
-				return
-			}
+	if len(params.CaptionEntities) != 0 {
+		var bs []byte
+		bs, err = json.Marshal(&params.CaptionEntities)
+		if err != nil {
+			return
 		}
 
-		if params.ParseMode != "" {
-			v := params.ParseMode
-			err = writer.WriteField("parse_mode", v)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("caption_entities")
+		if err != nil {
+			return
 		}
 
-		if len(params.CaptionEntities) != 0 {
-			var bs []byte
-			bs, err = json.Marshal(&params.CaptionEntities)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("caption_entities")
-			if err != nil {
-				return
-			}
+	if len(params.Areas) != 0 {
+		var bs []byte
+		bs, err = json.Marshal(&params.Areas)
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("areas")
+		if err != nil {
+			return
 		}
 
-		if len(params.Areas) != 0 {
-			var bs []byte
-			bs, err = json.Marshal(&params.Areas)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("areas")
-			if err != nil {
-				return
-			}
+	if err = writer.finish(); err != nil {
+		return
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
-		}
+	reader := writer.body()
 
-	}()
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "editStory", reader, contentType, nil)
+	result, err = c.call(ctx, "editStory", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -6518,19 +7050,31 @@ func WithEditUserStarSubscriptionIsCanceled(value bool) EditUserStarSubscription
 // Allows the bot to cancel or re-enable extension of a subscription paid in Telegram Stars.
 // Returns True on success.
 func (c *Client) EditUserStarSubscription(ctx context.Context, params *EditUserStarSubscriptionParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "editUserStarSubscription", reader, contentType, nil)
+	result, err = c.call(ctx, "editUserStarSubscription", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -6576,19 +7120,31 @@ func WithExportChatInviteLinkChatID(value string) ExportChatInviteLinkOption {
 // The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
 // Returns the new invite link as String on success.
 func (c *Client) ExportChatInviteLink(ctx context.Context, params *ExportChatInviteLinkParams) (ret string, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "exportChatInviteLink", reader, contentType, nil)
+	result, err = c.call(ctx, "exportChatInviteLink", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -6750,19 +7306,31 @@ func WithForwardMessageMessageID(value int64) ForwardMessageOption {
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) ForwardMessage(ctx context.Context, params *ForwardMessageParams) (ret *Message, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "forwardMessage", reader, contentType, nil)
+	result, err = c.call(ctx, "forwardMessage", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -6893,19 +7461,31 @@ func WithForwardMessagesProtectContent(value bool) ForwardMessagesOption {
 //
 // [MessageId]: https://core.telegram.org/bots/api#messageid
 func (c *Client) ForwardMessages(ctx context.Context, params *ForwardMessagesParams) (ret []MessageId, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "forwardMessages", reader, contentType, nil)
+	result, err = c.call(ctx, "forwardMessages", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -6943,19 +7523,31 @@ func (r *GetAvailableGiftsParams) Option(opts ...GetAvailableGiftsOption) (previ
 //
 // [Gifts]: https://core.telegram.org/bots/api#gifts
 func (c *Client) GetAvailableGifts(ctx context.Context, params *GetAvailableGiftsParams) (ret *Gifts, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getAvailableGifts", reader, contentType, nil)
+	result, err = c.call(ctx, "getAvailableGifts", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -7128,19 +7720,31 @@ func WithGetBusinessAccountGiftsLimit(value int64) GetBusinessAccountGiftsOption
 //
 // [OwnedGifts]: https://core.telegram.org/bots/api#ownedgifts
 func (c *Client) GetBusinessAccountGifts(ctx context.Context, params *GetBusinessAccountGiftsParams) (ret *OwnedGifts, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getBusinessAccountGifts", reader, contentType, nil)
+	result, err = c.call(ctx, "getBusinessAccountGifts", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -7189,19 +7793,31 @@ func WithGetBusinessAccountStarBalanceBusinessConnectionID(value string) GetBusi
 //
 // [StarAmount]: https://core.telegram.org/bots/api#staramount
 func (c *Client) GetBusinessAccountStarBalance(ctx context.Context, params *GetBusinessAccountStarBalanceParams) (ret *StarAmount, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getBusinessAccountStarBalance", reader, contentType, nil)
+	result, err = c.call(ctx, "getBusinessAccountStarBalance", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -7249,19 +7865,31 @@ func WithGetBusinessConnectionBusinessConnectionID(value string) GetBusinessConn
 //
 // [BusinessConnection]: https://core.telegram.org/bots/api#businessconnection
 func (c *Client) GetBusinessConnection(ctx context.Context, params *GetBusinessConnectionParams) (ret *BusinessConnection, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getBusinessConnection", reader, contentType, nil)
+	result, err = c.call(ctx, "getBusinessConnection", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -7309,19 +7937,31 @@ func WithGetChatChatID(value string) GetChatOption {
 //
 // [ChatFullInfo]: https://core.telegram.org/bots/api#chatfullinfo
 func (c *Client) GetChat(ctx context.Context, params *GetChatParams) (ret *ChatFullInfo, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getChat", reader, contentType, nil)
+	result, err = c.call(ctx, "getChat", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -7383,19 +8023,31 @@ func WithGetChatAdministratorsReturnBots(value bool) GetChatAdministratorsOption
 //
 // [ChatMember]: https://core.telegram.org/bots/api#chatmember
 func (c *Client) GetChatAdministrators(ctx context.Context, params *GetChatAdministratorsParams) (ret []ChatMember, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getChatAdministrators", reader, contentType, nil)
+	result, err = c.call(ctx, "getChatAdministrators", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -7571,19 +8223,31 @@ func WithGetChatGiftsLimit(value int64) GetChatGiftsOption {
 //
 // [OwnedGifts]: https://core.telegram.org/bots/api#ownedgifts
 func (c *Client) GetChatGifts(ctx context.Context, params *GetChatGiftsParams) (ret *OwnedGifts, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getChatGifts", reader, contentType, nil)
+	result, err = c.call(ctx, "getChatGifts", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -7644,19 +8308,31 @@ func WithGetChatMemberUserID(value int64) GetChatMemberOption {
 //
 // [ChatMember]: https://core.telegram.org/bots/api#chatmember
 func (c *Client) GetChatMember(ctx context.Context, params *GetChatMemberParams) (ret *ChatMember, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getChatMember", reader, contentType, nil)
+	result, err = c.call(ctx, "getChatMember", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -7702,19 +8378,31 @@ func WithGetChatMemberCountChatID(value string) GetChatMemberCountOption {
 // Use this method to get the number of members in a chat.
 // Returns Integer on success.
 func (c *Client) GetChatMemberCount(ctx context.Context, params *GetChatMemberCountParams) (ret int64, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getChatMemberCount", reader, contentType, nil)
+	result, err = c.call(ctx, "getChatMemberCount", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -7763,19 +8451,31 @@ func WithGetChatMenuButtonChatID(value int64) GetChatMenuButtonOption {
 //
 // [MenuButton]: https://core.telegram.org/bots/api#menubutton
 func (c *Client) GetChatMenuButton(ctx context.Context, params *GetChatMenuButtonParams) (ret *MenuButton, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getChatMenuButton", reader, contentType, nil)
+	result, err = c.call(ctx, "getChatMenuButton", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -7825,19 +8525,31 @@ func WithGetCustomEmojiStickersCustomEmojiIDs(value []string) GetCustomEmojiStic
 //
 // [Sticker]: https://core.telegram.org/bots/api#sticker
 func (c *Client) GetCustomEmojiStickers(ctx context.Context, params *GetCustomEmojiStickersParams) (ret []Sticker, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getCustomEmojiStickers", reader, contentType, nil)
+	result, err = c.call(ctx, "getCustomEmojiStickers", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -7892,19 +8604,31 @@ func WithGetFileFileID(value string) GetFileOption {
 // [File]: https://core.telegram.org/bots/api#file
 // [getFile]: https://core.telegram.org/bots/api#getfile
 func (c *Client) GetFile(ctx context.Context, params *GetFileParams) (ret *File, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getFile", reader, contentType, nil)
+	result, err = c.call(ctx, "getFile", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -7942,19 +8666,31 @@ func (r *GetForumTopicIconStickersParams) Option(opts ...GetForumTopicIconSticke
 //
 // [Sticker]: https://core.telegram.org/bots/api#sticker
 func (c *Client) GetForumTopicIconStickers(ctx context.Context, params *GetForumTopicIconStickersParams) (ret []Sticker, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getForumTopicIconStickers", reader, contentType, nil)
+	result, err = c.call(ctx, "getForumTopicIconStickers", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -8045,19 +8781,31 @@ func WithGetGameHighScoresInlineMessageID(value string) GetGameHighScoresOption
 //
 // [GameHighScore]: https://core.telegram.org/bots/api#gamehighscore
 func (c *Client) GetGameHighScores(ctx context.Context, params *GetGameHighScoresParams) (ret []GameHighScore, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getGameHighScores", reader, contentType, nil)
+	result, err = c.call(ctx, "getGameHighScores", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -8105,19 +8853,31 @@ func WithGetManagedBotAccessSettingsUserID(value int64) GetManagedBotAccessSetti
 //
 // [BotAccessSettings]: https://core.telegram.org/bots/api#botaccesssettings
 func (c *Client) GetManagedBotAccessSettings(ctx context.Context, params *GetManagedBotAccessSettingsParams) (ret *BotAccessSettings, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getManagedBotAccessSettings", reader, contentType, nil)
+	result, err = c.call(ctx, "getManagedBotAccessSettings", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -8163,19 +8923,31 @@ func WithGetManagedBotTokenUserID(value int64) GetManagedBotTokenOption {
 // Use this method to get the token of a managed bot.
 // Returns the token as String on success.
 func (c *Client) GetManagedBotToken(ctx context.Context, params *GetManagedBotTokenParams) (ret string, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getManagedBotToken", reader, contentType, nil)
+	result, err = c.call(ctx, "getManagedBotToken", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -8212,19 +8984,31 @@ func (r *GetMeParams) Option(opts ...GetMeOption) (previous GetMeOption) {
 //
 // [User]: https://core.telegram.org/bots/api#user
 func (c *Client) GetMe(ctx context.Context, params *GetMeParams) (ret *User, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getMe", reader, contentType, nil)
+	result, err = c.call(ctx, "getMe", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -8291,19 +9075,31 @@ func WithGetMyCommandsLanguageCode(value string) GetMyCommandsOption {
 //
 // [BotCommand]: https://core.telegram.org/bots/api#botcommand
 func (c *Client) GetMyCommands(ctx context.Context, params *GetMyCommandsParams) (ret []BotCommand, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getMyCommands", reader, contentType, nil)
+	result, err = c.call(ctx, "getMyCommands", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -8353,19 +9149,31 @@ func WithGetMyDefaultAdministratorRightsForChannels(value bool) GetMyDefaultAdmi
 //
 // [ChatAdministratorRights]: https://core.telegram.org/bots/api#chatadministratorrights
 func (c *Client) GetMyDefaultAdministratorRights(ctx context.Context, params *GetMyDefaultAdministratorRightsParams) (ret *ChatAdministratorRights, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getMyDefaultAdministratorRights", reader, contentType, nil)
+	result, err = c.call(ctx, "getMyDefaultAdministratorRights", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -8413,19 +9221,31 @@ func WithGetMyDescriptionLanguageCode(value string) GetMyDescriptionOption {
 //
 // [BotDescription]: https://core.telegram.org/bots/api#botdescription
 func (c *Client) GetMyDescription(ctx context.Context, params *GetMyDescriptionParams) (ret *BotDescription, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getMyDescription", reader, contentType, nil)
+	result, err = c.call(ctx, "getMyDescription", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -8473,19 +9293,31 @@ func WithGetMyNameLanguageCode(value string) GetMyNameOption {
 //
 // [BotName]: https://core.telegram.org/bots/api#botname
 func (c *Client) GetMyName(ctx context.Context, params *GetMyNameParams) (ret *BotName, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getMyName", reader, contentType, nil)
+	result, err = c.call(ctx, "getMyName", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -8533,19 +9365,31 @@ func WithGetMyShortDescriptionLanguageCode(value string) GetMyShortDescriptionOp
 //
 // [BotShortDescription]: https://core.telegram.org/bots/api#botshortdescription
 func (c *Client) GetMyShortDescription(ctx context.Context, params *GetMyShortDescriptionParams) (ret *BotShortDescription, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getMyShortDescription", reader, contentType, nil)
+	result, err = c.call(ctx, "getMyShortDescription", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -8583,19 +9427,31 @@ func (r *GetMyStarBalanceParams) Option(opts ...GetMyStarBalanceOption) (previou
 //
 // [StarAmount]: https://core.telegram.org/bots/api#staramount
 func (c *Client) GetMyStarBalance(ctx context.Context, params *GetMyStarBalanceParams) (ret *StarAmount, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getMyStarBalance", reader, contentType, nil)
+	result, err = c.call(ctx, "getMyStarBalance", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -8659,19 +9515,31 @@ func WithGetStarTransactionsLimit(value int64) GetStarTransactionsOption {
 //
 // [StarTransactions]: https://core.telegram.org/bots/api#startransactions
 func (c *Client) GetStarTransactions(ctx context.Context, params *GetStarTransactionsParams) (ret *StarTransactions, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getStarTransactions", reader, contentType, nil)
+	result, err = c.call(ctx, "getStarTransactions", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -8719,19 +9587,31 @@ func WithGetStickerSetName(value string) GetStickerSetOption {
 //
 // [StickerSet]: https://core.telegram.org/bots/api#stickerset
 func (c *Client) GetStickerSet(ctx context.Context, params *GetStickerSetParams) (ret *StickerSet, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getStickerSet", reader, contentType, nil)
+	result, err = c.call(ctx, "getStickerSet", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -8856,19 +9736,31 @@ func WithGetUpdatesAllowedUpdates(value []string) GetUpdatesOption {
 // [wiki]: https://en.wikipedia.org/wiki/Push_technology#Long_polling
 // [Update]: https://core.telegram.org/bots/api#update
 func (c *Client) GetUpdates(ctx context.Context, params *GetUpdatesParams) (ret []Update, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getUpdates", reader, contentType, nil)
+	result, err = c.call(ctx, "getUpdates", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -8929,19 +9821,31 @@ func WithGetUserChatBoostsUserID(value int64) GetUserChatBoostsOption {
 //
 // [UserChatBoosts]: https://core.telegram.org/bots/api#userchatboosts
 func (c *Client) GetUserChatBoosts(ctx context.Context, params *GetUserChatBoostsParams) (ret *UserChatBoosts, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getUserChatBoosts", reader, contentType, nil)
+	result, err = c.call(ctx, "getUserChatBoosts", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -9089,19 +9993,31 @@ func WithGetUserGiftsLimit(value int64) GetUserGiftsOption {
 //
 // [OwnedGifts]: https://core.telegram.org/bots/api#ownedgifts
 func (c *Client) GetUserGifts(ctx context.Context, params *GetUserGiftsParams) (ret *OwnedGifts, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getUserGifts", reader, contentType, nil)
+	result, err = c.call(ctx, "getUserGifts", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -9161,19 +10077,31 @@ func WithGetUserPersonalChatMessagesLimit(value int64) GetUserPersonalChatMessag
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) GetUserPersonalChatMessages(ctx context.Context, params *GetUserPersonalChatMessagesParams) (ret []Message, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getUserPersonalChatMessages", reader, contentType, nil)
+	result, err = c.call(ctx, "getUserPersonalChatMessages", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -9251,19 +10179,31 @@ func WithGetUserProfileAudiosLimit(value int64) GetUserProfileAudiosOption {
 //
 // [UserProfileAudios]: https://core.telegram.org/bots/api#userprofileaudios
 func (c *Client) GetUserProfileAudios(ctx context.Context, params *GetUserProfileAudiosParams) (ret *UserProfileAudios, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getUserProfileAudios", reader, contentType, nil)
+	result, err = c.call(ctx, "getUserProfileAudios", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -9341,19 +10281,31 @@ func WithGetUserProfilePhotosLimit(value int64) GetUserProfilePhotosOption {
 //
 // [UserProfilePhotos]: https://core.telegram.org/bots/api#userprofilephotos
 func (c *Client) GetUserProfilePhotos(ctx context.Context, params *GetUserProfilePhotosParams) (ret *UserProfilePhotos, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getUserProfilePhotos", reader, contentType, nil)
+	result, err = c.call(ctx, "getUserProfilePhotos", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -9393,19 +10345,31 @@ func (r *GetWebhookInfoParams) Option(opts ...GetWebhookInfoOption) (previous Ge
 // [WebhookInfo]: https://core.telegram.org/bots/api#webhookinfo
 // [getUpdates]: https://core.telegram.org/bots/api#getupdates
 func (c *Client) GetWebhookInfo(ctx context.Context, params *GetWebhookInfoParams) (ret *WebhookInfo, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "getWebhookInfo", reader, contentType, nil)
+	result, err = c.call(ctx, "getWebhookInfo", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -9523,19 +10487,31 @@ func WithGiftPremiumSubscriptionTextEntities(value []MessageEntity) GiftPremiumS
 // Gifts a Telegram Premium subscription to the given user.
 // Returns True on success.
 func (c *Client) GiftPremiumSubscription(ctx context.Context, params *GiftPremiumSubscriptionParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "giftPremiumSubscription", reader, contentType, nil)
+	result, err = c.call(ctx, "giftPremiumSubscription", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -9582,19 +10558,31 @@ func WithHideGeneralForumTopicChatID(value string) HideGeneralForumTopicOption {
 // The topic will be automatically closed if it was open.
 // Returns True on success.
 func (c *Client) HideGeneralForumTopic(ctx context.Context, params *HideGeneralForumTopicParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "hideGeneralForumTopic", reader, contentType, nil)
+	result, err = c.call(ctx, "hideGeneralForumTopic", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -9641,19 +10629,31 @@ func WithLeaveChatChatID(value string) LeaveChatOption {
 // Use this method for your bot to leave a group, supergroup or channel.
 // Returns True on success.
 func (c *Client) LeaveChat(ctx context.Context, params *LeaveChatParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "leaveChat", reader, contentType, nil)
+	result, err = c.call(ctx, "leaveChat", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -9690,19 +10690,31 @@ func (r *LogOutParams) Option(opts ...LogOutOption) (previous LogOutOption) {
 // Returns True on success.
 // Requires no parameters.
 func (c *Client) LogOut(ctx context.Context, params *LogOutParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "logOut", reader, contentType, nil)
+	result, err = c.call(ctx, "logOut", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -9787,19 +10799,31 @@ func WithPinChatMessageDisableNotification(value bool) PinChatMessageOption {
 // Conversely, the bot must be an administrator with the 'can_pin_messages' right or the 'can_edit_messages' right to pin messages in groups and channels respectively.
 // Returns True on success.
 func (c *Client) PinChatMessage(ctx context.Context, params *PinChatMessageParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "pinChatMessage", reader, contentType, nil)
+	result, err = c.call(ctx, "pinChatMessage", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -9949,167 +10973,156 @@ func WithPostStoryProtectContent(value bool) PostStoryOption {
 //
 // [Story]: https://core.telegram.org/bots/api#story
 func (c *Client) PostStory(ctx context.Context, params *PostStoryParams) (ret *Story, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
+	writer := c.newMultipartForm(ctx, "postStory")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
+
 	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
 
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	{
+		v := params.BusinessConnectionID
+		err = writer.WriteField("business_connection_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-			err = writer.Close()
+	{
+		switch {
+		case params.Content.InputStoryContentPhoto != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Content.InputStoryContentPhoto.Photo,
+				"content_photo_photo",
+			)
 			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
 				return
 			}
-
-			writeErr <- pw.Close()
-		}()
-
-		{
-			v := params.BusinessConnectionID
-			err = writer.WriteField("business_connection_id", v)
+		case params.Content.InputStoryContentVideo != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Content.InputStoryContentVideo.Video,
+				"content_video_video",
+			)
 			if err != nil {
 				return
 			}
 		}
 
-		{
-			switch {
-			case params.Content.InputStoryContentPhoto != nil:
-				err = createFormFileFromInputFile(
-					writer,
-					&params.Content.InputStoryContentPhoto.Photo,
-					"content_photo_photo",
-				)
-				if err != nil {
-					return
-				}
-			case params.Content.InputStoryContentVideo != nil:
-				err = createFormFileFromInputFile(
-					writer,
-					&params.Content.InputStoryContentVideo.Video,
-					"content_video_video",
-				)
-				if err != nil {
-					return
-				}
-			}
-
-			var bs []byte
-			bs, err = json.Marshal(&params.Content)
-			if err != nil {
-				return
-			}
+		var bs []byte
+		bs, err = json.Marshal(&params.Content)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("content")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("content")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := strconv.FormatInt(params.ActivePeriod, 10)
-			err = writer.WriteField("active_period", v)
-			if err != nil {
-				return
-			}
+	{
+		v := strconv.FormatInt(params.ActivePeriod, 10)
+		err = writer.WriteField("active_period", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Caption != "" {
-			v := params.Caption
-			err = writer.WriteField("caption", v)
-			if err != nil {
-				return
-			}
+	if params.Caption != "" {
+		v := params.Caption
+		err = writer.WriteField("caption", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ParseMode != "" {
-			v := params.ParseMode
-			err = writer.WriteField("parse_mode", v)
-			if err != nil {
-				return
-			}
+	if params.ParseMode != "" {
+		v := params.ParseMode
+		err = writer.WriteField("parse_mode", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if len(params.CaptionEntities) != 0 {
-			var bs []byte
-			bs, err = json.Marshal(&params.CaptionEntities)
-			if err != nil {
-				return
-			}
+	if len(params.CaptionEntities) != 0 {
+		var bs []byte
+		bs, err = json.Marshal(&params.CaptionEntities)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("caption_entities")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("caption_entities")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if len(params.Areas) != 0 {
-			var bs []byte
-			bs, err = json.Marshal(&params.Areas)
-			if err != nil {
-				return
-			}
+	if len(params.Areas) != 0 {
+		var bs []byte
+		bs, err = json.Marshal(&params.Areas)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("areas")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("areas")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.PostToChatPage {
-			v := strconv.FormatBool(params.PostToChatPage)
-			err = writer.WriteField("post_to_chat_page", v)
-			if err != nil {
-				return
-			}
+	if params.PostToChatPage {
+		v := strconv.FormatBool(params.PostToChatPage)
+		err = writer.WriteField("post_to_chat_page", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ProtectContent {
-			v := strconv.FormatBool(params.ProtectContent)
-			err = writer.WriteField("protect_content", v)
-			if err != nil {
-				return
-			}
+	if params.ProtectContent {
+		v := strconv.FormatBool(params.ProtectContent)
+		err = writer.WriteField("protect_content", v)
+		if err != nil {
+			return
 		}
+	}
+
+	if err = writer.finish(); err != nil {
+		return
+	}
+
+	reader := writer.body()
 
-	}()
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "postStory", reader, contentType, nil)
+	result, err = c.call(ctx, "postStory", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -10377,19 +11390,31 @@ func WithPromoteChatMemberCanManageTags(value bool) PromoteChatMemberOption {
 // Pass False for all boolean parameters to demote a user.
 // Returns True on success.
 func (c *Client) PromoteChatMember(ctx context.Context, params *PromoteChatMemberParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "promoteChatMember", reader, contentType, nil)
+	result, err = c.call(ctx, "promoteChatMember", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -10461,19 +11486,31 @@ func WithReadBusinessMessageMessageID(value int64) ReadBusinessMessageOption {
 // Requires the can_read_messages business bot right.
 // Returns True on success.
 func (c *Client) ReadBusinessMessage(ctx context.Context, params *ReadBusinessMessageParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "readBusinessMessage", reader, contentType, nil)
+	result, err = c.call(ctx, "readBusinessMessage", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -10532,19 +11569,31 @@ func WithRefundStarPaymentTelegramPaymentChargeID(value string) RefundStarPaymen
 //
 // [Telegram Stars]: https://t.me/BotNews/90
 func (c *Client) RefundStarPayment(ctx context.Context, params *RefundStarPaymentParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "refundStarPayment", reader, contentType, nil)
+	result, err = c.call(ctx, "refundStarPayment", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -10604,19 +11653,31 @@ func WithRemoveBusinessAccountProfilePhotoIsPublic(value bool) RemoveBusinessAcc
 // Requires the can_edit_profile_photo business bot right.
 // Returns True on success.
 func (c *Client) RemoveBusinessAccountProfilePhoto(ctx context.Context, params *RemoveBusinessAccountProfilePhotoParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "removeBusinessAccountProfilePhoto", reader, contentType, nil)
+	result, err = c.call(ctx, "removeBusinessAccountProfilePhoto", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -10663,19 +11724,31 @@ func WithRemoveChatVerificationChatID(value string) RemoveChatVerificationOption
 //
 // [on behalf of the organization]: https://telegram.org/verify#third-party-verification
 func (c *Client) RemoveChatVerification(ctx context.Context, params *RemoveChatVerificationParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "removeChatVerification", reader, contentType, nil)
+	result, err = c.call(ctx, "removeChatVerification", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -10710,19 +11783,31 @@ func (r *RemoveMyProfilePhotoParams) Option(opts ...RemoveMyProfilePhotoOption)
 // Requires no parameters.
 // Returns True on success.
 func (c *Client) RemoveMyProfilePhoto(ctx context.Context, params *RemoveMyProfilePhotoParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "removeMyProfilePhoto", reader, contentType, nil)
+	result, err = c.call(ctx, "removeMyProfilePhoto", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -10769,19 +11854,31 @@ func WithRemoveUserVerificationUserID(value int64) RemoveUserVerificationOption
 //
 // [on behalf of the organization]: https://telegram.org/verify#third-party-verification
 func (c *Client) RemoveUserVerification(ctx context.Context, params *RemoveUserVerificationParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "removeUserVerification", reader, contentType, nil)
+	result, err = c.call(ctx, "removeUserVerification", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -10839,19 +11936,31 @@ func WithReopenForumTopicMessageThreadID(value int64) ReopenForumTopicOption {
 // The bot must be an administrator in the chat for this to work and must have the can_manage_topics administrator rights, unless it is the creator of the topic.
 // Returns True on success.
 func (c *Client) ReopenForumTopic(ctx context.Context, params *ReopenForumTopicParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "reopenForumTopic", reader, contentType, nil)
+	result, err = c.call(ctx, "reopenForumTopic", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -10898,19 +12007,31 @@ func WithReopenGeneralForumTopicChatID(value string) ReopenGeneralForumTopicOpti
 // The topic will be automatically unhidden if it was hidden.
 // Returns True on success.
 func (c *Client) ReopenGeneralForumTopic(ctx context.Context, params *ReopenGeneralForumTopicParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "reopenGeneralForumTopic", reader, contentType, nil)
+	result, err = c.call(ctx, "reopenGeneralForumTopic", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -10955,19 +12076,31 @@ func WithReplaceManagedBotTokenUserID(value int64) ReplaceManagedBotTokenOption
 // Use this method to revoke the current token of a managed bot and generate a new one.
 // Returns the new token as String on success.
 func (c *Client) ReplaceManagedBotToken(ctx context.Context, params *ReplaceManagedBotTokenParams) (ret string, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "replaceManagedBotToken", reader, contentType, nil)
+	result, err = c.call(ctx, "replaceManagedBotToken", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -11055,93 +12188,82 @@ func WithReplaceStickerInSetSticker(value InputSticker) ReplaceStickerInSetOptio
 // [addStickerToSet]: https://core.telegram.org/bots/api#addstickertoset
 // [setStickerPositionInSet]: https://core.telegram.org/bots/api#setstickerpositioninset
 func (c *Client) ReplaceStickerInSet(ctx context.Context, params *ReplaceStickerInSetParams) (ret bool, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
-	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
+	writer := c.newMultipartForm(ctx, "replaceStickerInSet")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
 
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	contentType := writer.FormDataContentType()
 
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	{
+		v := strconv.FormatInt(params.UserID, 10)
+		err = writer.WriteField("user_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-			writeErr <- pw.Close()
-		}()
+	{
+		v := params.Name
+		err = writer.WriteField("name", v)
+		if err != nil {
+			return
+		}
+	}
 
-		{
-			v := strconv.FormatInt(params.UserID, 10)
-			err = writer.WriteField("user_id", v)
-			if err != nil {
-				return
-			}
+	{
+		v := params.OldSticker
+		err = writer.WriteField("old_sticker", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := params.Name
-			err = writer.WriteField("name", v)
-			if err != nil {
-				return
-			}
+	{
+		err = createFormFileFromInputFile(
+			writer,
+			&params.Sticker.Sticker,
+			"sticker__sticker",
+		)
+		if err != nil {
+			return
 		}
 
-		{
-			v := params.OldSticker
-			err = writer.WriteField("old_sticker", v)
-			if err != nil {
-				return
-			}
+		var bs []byte
+		bs, err = json.Marshal(&params.Sticker)
+		if err != nil {
+			return
 		}
 
-		{
-			err = createFormFileFromInputFile(
-				writer,
-				&params.Sticker.Sticker,
-				"sticker__sticker",
-			)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("sticker")
+		if err != nil {
+			return
+		}
 
-			var bs []byte
-			bs, err = json.Marshal(&params.Sticker)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("sticker")
-			if err != nil {
-				return
-			}
+	if err = writer.finish(); err != nil {
+		return
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
-		}
+	reader := writer.body()
 
-	}()
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "replaceStickerInSet", reader, contentType, nil)
+	result, err = c.call(ctx, "replaceStickerInSet", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -11250,19 +12372,31 @@ func WithRepostStoryProtectContent(value bool) RepostStoryOption {
 //
 // [Story]: https://core.telegram.org/bots/api#story
 func (c *Client) RepostStory(ctx context.Context, params *RepostStoryParams) (ret *Story, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "repostStory", reader, contentType, nil)
+	result, err = c.call(ctx, "repostStory", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -11362,19 +12496,31 @@ func WithRestrictChatMemberUntilDate(value int64) RestrictChatMemberOption {
 // Pass True for all permissions to lift restrictions from a user.
 // Returns True on success.
 func (c *Client) RestrictChatMember(ctx context.Context, params *RestrictChatMemberParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "restrictChatMember", reader, contentType, nil)
+	result, err = c.call(ctx, "restrictChatMember", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -11435,19 +12581,31 @@ func WithRevokeChatInviteLinkInviteLink(value string) RevokeChatInviteLinkOption
 //
 // [ChatInviteLink]: https://core.telegram.org/bots/api#chatinvitelink
 func (c *Client) RevokeChatInviteLink(ctx context.Context, params *RevokeChatInviteLinkParams) (ret *ChatInviteLink, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "revokeChatInviteLink", reader, contentType, nil)
+	result, err = c.call(ctx, "revokeChatInviteLink", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -11555,19 +12713,31 @@ func WithSavePreparedInlineMessageAllowChannelChats(value bool) SavePreparedInli
 //
 // [PreparedInlineMessage]: https://core.telegram.org/bots/api#preparedinlinemessage
 func (c *Client) SavePreparedInlineMessage(ctx context.Context, params *SavePreparedInlineMessageParams) (ret *PreparedInlineMessage, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "savePreparedInlineMessage", reader, contentType, nil)
+	result, err = c.call(ctx, "savePreparedInlineMessage", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -11629,19 +12799,31 @@ func WithSavePreparedKeyboardButtonButton(value KeyboardButton) SavePreparedKeyb
 //
 // [PreparedKeyboardButton]: https://core.telegram.org/bots/api#preparedkeyboardbutton
 func (c *Client) SavePreparedKeyboardButton(ctx context.Context, params *SavePreparedKeyboardButtonParams) (ret *PreparedKeyboardButton, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "savePreparedKeyboardButton", reader, contentType, nil)
+	result, err = c.call(ctx, "savePreparedKeyboardButton", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -12012,279 +13194,268 @@ func WithSendAnimationReplyMarkup(value *ReplyMarkup) SendAnimationOption {
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendAnimation(ctx context.Context, params *SendAnimationParams) (ret *Message, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
+	writer := c.newMultipartForm(ctx, "sendAnimation")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
+
 	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
 
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	if params.BusinessConnectionID != "" {
+		v := params.BusinessConnectionID
+		err = writer.WriteField("business_connection_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	{
+		v := params.ChatID
+		err = writer.WriteField("chat_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-			writeErr <- pw.Close()
-		}()
+	if params.MessageThreadID != 0 {
+		v := strconv.FormatInt(params.MessageThreadID, 10)
+		err = writer.WriteField("message_thread_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-		if params.BusinessConnectionID != "" {
-			v := params.BusinessConnectionID
-			err = writer.WriteField("business_connection_id", v)
-			if err != nil {
-				return
-			}
+	if params.DirectMessagesTopicID != 0 {
+		v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
+		err = writer.WriteField("direct_messages_topic_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := params.ChatID
-			err = writer.WriteField("chat_id", v)
-			if err != nil {
-				return
-			}
+	if params.ReceiverUserID != 0 {
+		v := strconv.FormatInt(params.ReceiverUserID, 10)
+		err = writer.WriteField("receiver_user_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageThreadID != 0 {
-			v := strconv.FormatInt(params.MessageThreadID, 10)
-			err = writer.WriteField("message_thread_id", v)
-			if err != nil {
-				return
-			}
+	if params.CallbackQueryID != "" {
+		v := params.CallbackQueryID
+		err = writer.WriteField("callback_query_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DirectMessagesTopicID != 0 {
-			v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
-			err = writer.WriteField("direct_messages_topic_id", v)
-			if err != nil {
-				return
-			}
+	{
+		err = writeFormInputFile(
+			writer,
+			&params.Animation,
+			"animation",
+		)
+		if err != nil {
+			return
 		}
 
-		if params.ReceiverUserID != 0 {
-			v := strconv.FormatInt(params.ReceiverUserID, 10)
-			err = writer.WriteField("receiver_user_id", v)
-			if err != nil {
-				return
-			}
+	}
+
+	if params.Duration != 0 {
+		v := strconv.FormatInt(params.Duration, 10)
+		err = writer.WriteField("duration", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.CallbackQueryID != "" {
-			v := params.CallbackQueryID
-			err = writer.WriteField("callback_query_id", v)
-			if err != nil {
-				return
-			}
+	if params.Width != 0 {
+		v := strconv.FormatInt(params.Width, 10)
+		err = writer.WriteField("width", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			err = createFormFileFromInputFile(
+	if params.Height != 0 {
+		v := strconv.FormatInt(params.Height, 10)
+		err = writer.WriteField("height", v)
+		if err != nil {
+			return
+		}
+	}
+
+	{
+		if params.Thumbnail != nil {
+			err = writeFormInputFile(
 				writer,
-				&params.Animation,
-				"animation",
+				params.Thumbnail,
+				"thumbnail",
 			)
 			if err != nil {
 				return
 			}
+		}
+
+	}
 
+	if params.Caption != "" {
+		v := params.Caption
+		err = writer.WriteField("caption", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Duration != 0 {
-			v := strconv.FormatInt(params.Duration, 10)
-			err = writer.WriteField("duration", v)
-			if err != nil {
-				return
-			}
+	if params.ParseMode != "" {
+		v := params.ParseMode
+		err = writer.WriteField("parse_mode", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Width != 0 {
-			v := strconv.FormatInt(params.Width, 10)
-			err = writer.WriteField("width", v)
-			if err != nil {
-				return
-			}
+	if len(params.CaptionEntities) != 0 {
+		var bs []byte
+		bs, err = json.Marshal(&params.CaptionEntities)
+		if err != nil {
+			return
 		}
 
-		if params.Height != 0 {
-			v := strconv.FormatInt(params.Height, 10)
-			err = writer.WriteField("height", v)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("caption_entities")
+		if err != nil {
+			return
 		}
 
-		{
-			if params.Thumbnail != nil {
-				err = createFormFileFromInputFile(
-					writer,
-					params.Thumbnail,
-					"thumbnail",
-				)
-				if err != nil {
-					return
-				}
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
+	if params.ShowCaptionAboveMedia {
+		v := strconv.FormatBool(params.ShowCaptionAboveMedia)
+		err = writer.WriteField("show_caption_above_media", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Caption != "" {
-			v := params.Caption
-			err = writer.WriteField("caption", v)
-			if err != nil {
-				return
-			}
+	if params.HasSpoiler {
+		v := strconv.FormatBool(params.HasSpoiler)
+		err = writer.WriteField("has_spoiler", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ParseMode != "" {
-			v := params.ParseMode
-			err = writer.WriteField("parse_mode", v)
-			if err != nil {
-				return
-			}
+	if params.DisableNotification {
+		v := strconv.FormatBool(params.DisableNotification)
+		err = writer.WriteField("disable_notification", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if len(params.CaptionEntities) != 0 {
-			var bs []byte
-			bs, err = json.Marshal(&params.CaptionEntities)
-			if err != nil {
-				return
-			}
+	if params.ProtectContent {
+		v := strconv.FormatBool(params.ProtectContent)
+		err = writer.WriteField("protect_content", v)
+		if err != nil {
+			return
+		}
+	}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("caption_entities")
-			if err != nil {
-				return
-			}
+	if params.AllowPaidBroadcast {
+		v := strconv.FormatBool(params.AllowPaidBroadcast)
+		err = writer.WriteField("allow_paid_broadcast", v)
+		if err != nil {
+			return
+		}
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+	if params.MessageEffectID != "" {
+		v := params.MessageEffectID
+		err = writer.WriteField("message_effect_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ShowCaptionAboveMedia {
-			v := strconv.FormatBool(params.ShowCaptionAboveMedia)
-			err = writer.WriteField("show_caption_above_media", v)
-			if err != nil {
-				return
-			}
+	if params.SuggestedPostParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.SuggestedPostParameters)
+		if err != nil {
+			return
 		}
 
-		if params.HasSpoiler {
-			v := strconv.FormatBool(params.HasSpoiler)
-			err = writer.WriteField("has_spoiler", v)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("suggested_post_parameters")
+		if err != nil {
+			return
 		}
 
-		if params.DisableNotification {
-			v := strconv.FormatBool(params.DisableNotification)
-			err = writer.WriteField("disable_notification", v)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ProtectContent {
-			v := strconv.FormatBool(params.ProtectContent)
-			err = writer.WriteField("protect_content", v)
-			if err != nil {
-				return
-			}
+	if params.ReplyParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyParameters)
+		if err != nil {
+			return
 		}
 
-		if params.AllowPaidBroadcast {
-			v := strconv.FormatBool(params.AllowPaidBroadcast)
-			err = writer.WriteField("allow_paid_broadcast", v)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_parameters")
+		if err != nil {
+			return
 		}
 
-		if params.MessageEffectID != "" {
-			v := params.MessageEffectID
-			err = writer.WriteField("message_effect_id", v)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.SuggestedPostParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.SuggestedPostParameters)
-			if err != nil {
-				return
-			}
+	if params.ReplyMarkup != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyMarkup)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("suggested_post_parameters")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_markup")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReplyParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyParameters)
-			if err != nil {
-				return
-			}
+	if err = writer.finish(); err != nil {
+		return
+	}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_parameters")
-			if err != nil {
-				return
-			}
+	reader := writer.body()
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
-		}
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
-		if params.ReplyMarkup != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyMarkup)
-			if err != nil {
-				return
-			}
+	var result json.RawMessage
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_markup")
-			if err != nil {
-				return
-			}
+	result, err = c.call(ctx, "sendAnimation", params, reader, contentType, response)
+	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
-		}
-
-	}()
-
-	var result json.RawMessage
-
-	result, err = c.Raw(ctx, "sendAnimation", reader, contentType, nil)
-	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
 		return
 	}
 
@@ -12634,263 +13805,252 @@ func WithSendAudioReplyMarkup(value *ReplyMarkup) SendAudioOption {
 // [Message]: https://core.telegram.org/bots/api#message
 // [sendVoice]: https://core.telegram.org/bots/api#sendvoice
 func (c *Client) SendAudio(ctx context.Context, params *SendAudioParams) (ret *Message, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
-	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
-
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	writer := c.newMultipartForm(ctx, "sendAudio")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
 
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	contentType := writer.FormDataContentType()
 
-			writeErr <- pw.Close()
-		}()
+	if params.BusinessConnectionID != "" {
+		v := params.BusinessConnectionID
+		err = writer.WriteField("business_connection_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-		if params.BusinessConnectionID != "" {
-			v := params.BusinessConnectionID
-			err = writer.WriteField("business_connection_id", v)
-			if err != nil {
-				return
-			}
+	{
+		v := params.ChatID
+		err = writer.WriteField("chat_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := params.ChatID
-			err = writer.WriteField("chat_id", v)
-			if err != nil {
-				return
-			}
+	if params.MessageThreadID != 0 {
+		v := strconv.FormatInt(params.MessageThreadID, 10)
+		err = writer.WriteField("message_thread_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageThreadID != 0 {
-			v := strconv.FormatInt(params.MessageThreadID, 10)
-			err = writer.WriteField("message_thread_id", v)
-			if err != nil {
-				return
-			}
+	if params.DirectMessagesTopicID != 0 {
+		v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
+		err = writer.WriteField("direct_messages_topic_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DirectMessagesTopicID != 0 {
-			v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
-			err = writer.WriteField("direct_messages_topic_id", v)
-			if err != nil {
-				return
-			}
+	if params.ReceiverUserID != 0 {
+		v := strconv.FormatInt(params.ReceiverUserID, 10)
+		err = writer.WriteField("receiver_user_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReceiverUserID != 0 {
-			v := strconv.FormatInt(params.ReceiverUserID, 10)
-			err = writer.WriteField("receiver_user_id", v)
-			if err != nil {
-				return
-			}
+	if params.CallbackQueryID != "" {
+		v := params.CallbackQueryID
+		err = writer.WriteField("callback_query_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.CallbackQueryID != "" {
-			v := params.CallbackQueryID
-			err = writer.WriteField("callback_query_id", v)
-			if err != nil {
-				return
-			}
+	{
+		err = writeFormInputFile(
+			writer,
+			&params.Audio,
+			"audio",
+		)
+		if err != nil {
+			return
 		}
 
-		{
-			err = createFormFileFromInputFile(
-				writer,
-				&params.Audio,
-				"audio",
-			)
-			if err != nil {
-				return
-			}
+	}
 
+	if params.Caption != "" {
+		v := params.Caption
+		err = writer.WriteField("caption", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Caption != "" {
-			v := params.Caption
-			err = writer.WriteField("caption", v)
-			if err != nil {
-				return
-			}
+	if params.ParseMode != "" {
+		v := params.ParseMode
+		err = writer.WriteField("parse_mode", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ParseMode != "" {
-			v := params.ParseMode
-			err = writer.WriteField("parse_mode", v)
-			if err != nil {
-				return
-			}
+	if len(params.CaptionEntities) != 0 {
+		var bs []byte
+		bs, err = json.Marshal(&params.CaptionEntities)
+		if err != nil {
+			return
 		}
 
-		if len(params.CaptionEntities) != 0 {
-			var bs []byte
-			bs, err = json.Marshal(&params.CaptionEntities)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("caption_entities")
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("caption_entities")
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+	if params.Duration != 0 {
+		v := strconv.FormatInt(params.Duration, 10)
+		err = writer.WriteField("duration", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Duration != 0 {
-			v := strconv.FormatInt(params.Duration, 10)
-			err = writer.WriteField("duration", v)
-			if err != nil {
-				return
-			}
+	if params.Performer != "" {
+		v := params.Performer
+		err = writer.WriteField("performer", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Performer != "" {
-			v := params.Performer
-			err = writer.WriteField("performer", v)
-			if err != nil {
-				return
-			}
+	if params.Title != "" {
+		v := params.Title
+		err = writer.WriteField("title", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Title != "" {
-			v := params.Title
-			err = writer.WriteField("title", v)
+	{
+		if params.Thumbnail != nil {
+			err = writeFormInputFile(
+				writer,
+				params.Thumbnail,
+				"thumbnail",
+			)
 			if err != nil {
 				return
 			}
 		}
 
-		{
-			if params.Thumbnail != nil {
-				err = createFormFileFromInputFile(
-					writer,
-					params.Thumbnail,
-					"thumbnail",
-				)
-				if err != nil {
-					return
-				}
-			}
+	}
 
+	if params.DisableNotification {
+		v := strconv.FormatBool(params.DisableNotification)
+		err = writer.WriteField("disable_notification", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DisableNotification {
-			v := strconv.FormatBool(params.DisableNotification)
-			err = writer.WriteField("disable_notification", v)
-			if err != nil {
-				return
-			}
+	if params.ProtectContent {
+		v := strconv.FormatBool(params.ProtectContent)
+		err = writer.WriteField("protect_content", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ProtectContent {
-			v := strconv.FormatBool(params.ProtectContent)
-			err = writer.WriteField("protect_content", v)
-			if err != nil {
-				return
-			}
+	if params.AllowPaidBroadcast {
+		v := strconv.FormatBool(params.AllowPaidBroadcast)
+		err = writer.WriteField("allow_paid_broadcast", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.AllowPaidBroadcast {
-			v := strconv.FormatBool(params.AllowPaidBroadcast)
-			err = writer.WriteField("allow_paid_broadcast", v)
-			if err != nil {
-				return
-			}
+	if params.MessageEffectID != "" {
+		v := params.MessageEffectID
+		err = writer.WriteField("message_effect_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageEffectID != "" {
-			v := params.MessageEffectID
-			err = writer.WriteField("message_effect_id", v)
-			if err != nil {
-				return
-			}
+	if params.SuggestedPostParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.SuggestedPostParameters)
+		if err != nil {
+			return
 		}
 
-		if params.SuggestedPostParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.SuggestedPostParameters)
-			if err != nil {
-				return
-			}
-
-			var p io.Writer
-			p, err = writer.CreateFormField("suggested_post_parameters")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("suggested_post_parameters")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReplyParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyParameters)
-			if err != nil {
-				return
-			}
+	if params.ReplyParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyParameters)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_parameters")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_parameters")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReplyMarkup != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyMarkup)
-			if err != nil {
-				return
-			}
+	if params.ReplyMarkup != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyMarkup)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_markup")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_markup")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
+
+	if err = writer.finish(); err != nil {
+		return
+	}
+
+	reader := writer.body()
 
-	}()
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendAudio", reader, contentType, nil)
+	result, err = c.call(ctx, "sendAudio", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -12996,19 +14156,31 @@ func WithSendChatActionAction(value string) SendChatActionOption {
 // Returns True on success.
 // We only recommend using this method when a response from the bot will take a noticeable amount of time to arrive.
 func (c *Client) SendChatAction(ctx context.Context, params *SendChatActionParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendChatAction", reader, contentType, nil)
+	result, err = c.call(ctx, "sendChatAction", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -13072,19 +14244,31 @@ func WithSendChatJoinRequestWebAppWebAppUrl(value string) SendChatJoinRequestWeb
 //
 // [answerChatJoinRequestQuery]: https://core.telegram.org/bots/api#answerchatjoinrequestquery
 func (c *Client) SendChatJoinRequestWebApp(ctx context.Context, params *SendChatJoinRequestWebAppParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendChatJoinRequestWebApp", reader, contentType, nil)
+	result, err = c.call(ctx, "sendChatJoinRequestWebApp", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -13221,19 +14405,31 @@ func WithSendChecklistReplyMarkup(value *InlineKeyboardMarkup) SendChecklistOpti
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendChecklist(ctx context.Context, params *SendChecklistParams) (ret *Message, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendChecklist", reader, contentType, nil)
+	result, err = c.call(ctx, "sendChecklist", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -13507,19 +14703,31 @@ func WithSendContactReplyMarkup(value *ReplyMarkup) SendContactOption {
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendContact(ctx context.Context, params *SendContactParams) (ret *Message, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendContact", reader, contentType, nil)
+	result, err = c.call(ctx, "sendContact", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -13727,19 +14935,31 @@ func WithSendDiceReplyMarkup(value *ReplyMarkup) SendDiceOption {
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendDice(ctx context.Context, params *SendDiceParams) (ret *Message, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendDice", reader, contentType, nil)
+	result, err = c.call(ctx, "sendDice", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -14062,247 +15282,236 @@ func WithSendDocumentReplyMarkup(value *ReplyMarkup) SendDocumentOption {
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendDocument(ctx context.Context, params *SendDocumentParams) (ret *Message, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
-	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
-
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	writer := c.newMultipartForm(ctx, "sendDocument")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
 
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	contentType := writer.FormDataContentType()
 
-			writeErr <- pw.Close()
-		}()
+	if params.BusinessConnectionID != "" {
+		v := params.BusinessConnectionID
+		err = writer.WriteField("business_connection_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-		if params.BusinessConnectionID != "" {
-			v := params.BusinessConnectionID
-			err = writer.WriteField("business_connection_id", v)
-			if err != nil {
-				return
-			}
+	{
+		v := params.ChatID
+		err = writer.WriteField("chat_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := params.ChatID
-			err = writer.WriteField("chat_id", v)
-			if err != nil {
-				return
-			}
+	if params.MessageThreadID != 0 {
+		v := strconv.FormatInt(params.MessageThreadID, 10)
+		err = writer.WriteField("message_thread_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageThreadID != 0 {
-			v := strconv.FormatInt(params.MessageThreadID, 10)
-			err = writer.WriteField("message_thread_id", v)
-			if err != nil {
-				return
-			}
+	if params.DirectMessagesTopicID != 0 {
+		v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
+		err = writer.WriteField("direct_messages_topic_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DirectMessagesTopicID != 0 {
-			v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
-			err = writer.WriteField("direct_messages_topic_id", v)
-			if err != nil {
-				return
-			}
+	if params.ReceiverUserID != 0 {
+		v := strconv.FormatInt(params.ReceiverUserID, 10)
+		err = writer.WriteField("receiver_user_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReceiverUserID != 0 {
-			v := strconv.FormatInt(params.ReceiverUserID, 10)
-			err = writer.WriteField("receiver_user_id", v)
-			if err != nil {
-				return
-			}
+	if params.CallbackQueryID != "" {
+		v := params.CallbackQueryID
+		err = writer.WriteField("callback_query_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.CallbackQueryID != "" {
-			v := params.CallbackQueryID
-			err = writer.WriteField("callback_query_id", v)
-			if err != nil {
-				return
-			}
+	{
+		err = writeFormInputFile(
+			writer,
+			&params.Document,
+			"document",
+		)
+		if err != nil {
+			return
 		}
 
-		{
-			err = createFormFileFromInputFile(
+	}
+
+	{
+		if params.Thumbnail != nil {
+			err = writeFormInputFile(
 				writer,
-				&params.Document,
-				"document",
+				params.Thumbnail,
+				"thumbnail",
 			)
 			if err != nil {
 				return
 			}
-
 		}
 
-		{
-			if params.Thumbnail != nil {
-				err = createFormFileFromInputFile(
-					writer,
-					params.Thumbnail,
-					"thumbnail",
-				)
-				if err != nil {
-					return
-				}
-			}
+	}
 
+	if params.Caption != "" {
+		v := params.Caption
+		err = writer.WriteField("caption", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Caption != "" {
-			v := params.Caption
-			err = writer.WriteField("caption", v)
-			if err != nil {
-				return
-			}
+	if params.ParseMode != "" {
+		v := params.ParseMode
+		err = writer.WriteField("parse_mode", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ParseMode != "" {
-			v := params.ParseMode
-			err = writer.WriteField("parse_mode", v)
-			if err != nil {
-				return
-			}
+	if len(params.CaptionEntities) != 0 {
+		var bs []byte
+		bs, err = json.Marshal(&params.CaptionEntities)
+		if err != nil {
+			return
 		}
 
-		if len(params.CaptionEntities) != 0 {
-			var bs []byte
-			bs, err = json.Marshal(&params.CaptionEntities)
-			if err != nil {
-				return
-			}
-
-			var p io.Writer
-			p, err = writer.CreateFormField("caption_entities")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("caption_entities")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DisableContentTypeDetection {
-			v := strconv.FormatBool(params.DisableContentTypeDetection)
-			err = writer.WriteField("disable_content_type_detection", v)
-			if err != nil {
-				return
-			}
+	if params.DisableContentTypeDetection {
+		v := strconv.FormatBool(params.DisableContentTypeDetection)
+		err = writer.WriteField("disable_content_type_detection", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DisableNotification {
-			v := strconv.FormatBool(params.DisableNotification)
-			err = writer.WriteField("disable_notification", v)
-			if err != nil {
-				return
-			}
+	if params.DisableNotification {
+		v := strconv.FormatBool(params.DisableNotification)
+		err = writer.WriteField("disable_notification", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ProtectContent {
-			v := strconv.FormatBool(params.ProtectContent)
-			err = writer.WriteField("protect_content", v)
-			if err != nil {
-				return
-			}
+	if params.ProtectContent {
+		v := strconv.FormatBool(params.ProtectContent)
+		err = writer.WriteField("protect_content", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.AllowPaidBroadcast {
-			v := strconv.FormatBool(params.AllowPaidBroadcast)
-			err = writer.WriteField("allow_paid_broadcast", v)
-			if err != nil {
-				return
-			}
+	if params.AllowPaidBroadcast {
+		v := strconv.FormatBool(params.AllowPaidBroadcast)
+		err = writer.WriteField("allow_paid_broadcast", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageEffectID != "" {
-			v := params.MessageEffectID
-			err = writer.WriteField("message_effect_id", v)
-			if err != nil {
-				return
-			}
+	if params.MessageEffectID != "" {
+		v := params.MessageEffectID
+		err = writer.WriteField("message_effect_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.SuggestedPostParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.SuggestedPostParameters)
-			if err != nil {
-				return
-			}
+	if params.SuggestedPostParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.SuggestedPostParameters)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("suggested_post_parameters")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("suggested_post_parameters")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReplyParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyParameters)
-			if err != nil {
-				return
-			}
+	if params.ReplyParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyParameters)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_parameters")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_parameters")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReplyMarkup != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyMarkup)
-			if err != nil {
-				return
-			}
+	if params.ReplyMarkup != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyMarkup)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_markup")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_markup")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
+
+	if err = writer.finish(); err != nil {
+		return
+	}
+
+	reader := writer.body()
 
-	}()
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendDocument", reader, contentType, nil)
+	result, err = c.call(ctx, "sendDocument", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -14486,19 +15695,31 @@ func WithSendGameReplyMarkup(value *InlineKeyboardMarkup) SendGameOption {
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendGame(ctx context.Context, params *SendGameParams) (ret *Message, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendGame", reader, contentType, nil)
+	result, err = c.call(ctx, "sendGame", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -14633,19 +15854,31 @@ func WithSendGiftTextEntities(value []MessageEntity) SendGiftOption {
 // The gift can't be converted to Telegram Stars by the receiver.
 // Returns True on success.
 func (c *Client) SendGift(ctx context.Context, params *SendGiftParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendGift", reader, contentType, nil)
+	result, err = c.call(ctx, "sendGift", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -15170,19 +16403,31 @@ func WithSendInvoiceReplyMarkup(value *InlineKeyboardMarkup) SendInvoiceOption {
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendInvoice(ctx context.Context, params *SendInvoiceParams) (ret *Message, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendInvoice", reader, contentType, nil)
+	result, err = c.call(ctx, "sendInvoice", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -15516,253 +16761,242 @@ func WithSendLivePhotoReplyMarkup(value *ReplyMarkup) SendLivePhotoOption {
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendLivePhoto(ctx context.Context, params *SendLivePhotoParams) (ret *Message, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
-	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
-
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	writer := c.newMultipartForm(ctx, "sendLivePhoto")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
 
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	contentType := writer.FormDataContentType()
 
-			writeErr <- pw.Close()
-		}()
+	if params.BusinessConnectionID != "" {
+		v := params.BusinessConnectionID
+		err = writer.WriteField("business_connection_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-		if params.BusinessConnectionID != "" {
-			v := params.BusinessConnectionID
-			err = writer.WriteField("business_connection_id", v)
-			if err != nil {
-				return
-			}
+	{
+		v := params.ChatID
+		err = writer.WriteField("chat_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := params.ChatID
-			err = writer.WriteField("chat_id", v)
-			if err != nil {
-				return
-			}
+	if params.MessageThreadID != 0 {
+		v := strconv.FormatInt(params.MessageThreadID, 10)
+		err = writer.WriteField("message_thread_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageThreadID != 0 {
-			v := strconv.FormatInt(params.MessageThreadID, 10)
-			err = writer.WriteField("message_thread_id", v)
-			if err != nil {
-				return
-			}
+	if params.DirectMessagesTopicID != 0 {
+		v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
+		err = writer.WriteField("direct_messages_topic_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DirectMessagesTopicID != 0 {
-			v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
-			err = writer.WriteField("direct_messages_topic_id", v)
-			if err != nil {
-				return
-			}
+	if params.ReceiverUserID != 0 {
+		v := strconv.FormatInt(params.ReceiverUserID, 10)
+		err = writer.WriteField("receiver_user_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReceiverUserID != 0 {
-			v := strconv.FormatInt(params.ReceiverUserID, 10)
-			err = writer.WriteField("receiver_user_id", v)
-			if err != nil {
-				return
-			}
+	if params.CallbackQueryID != "" {
+		v := params.CallbackQueryID
+		err = writer.WriteField("callback_query_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.CallbackQueryID != "" {
-			v := params.CallbackQueryID
-			err = writer.WriteField("callback_query_id", v)
-			if err != nil {
-				return
-			}
+	{
+		err = writeFormInputFile(
+			writer,
+			&params.LivePhoto,
+			"live_photo",
+		)
+		if err != nil {
+			return
 		}
 
-		{
-			err = createFormFileFromInputFile(
-				writer,
-				&params.LivePhoto,
-				"live_photo",
-			)
-			if err != nil {
-				return
-			}
+	}
 
+	{
+		err = writeFormInputFile(
+			writer,
+			&params.Photo,
+			"photo",
+		)
+		if err != nil {
+			return
 		}
 
-		{
-			err = createFormFileFromInputFile(
-				writer,
-				&params.Photo,
-				"photo",
-			)
-			if err != nil {
-				return
-			}
+	}
 
+	if params.Caption != "" {
+		v := params.Caption
+		err = writer.WriteField("caption", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Caption != "" {
-			v := params.Caption
-			err = writer.WriteField("caption", v)
-			if err != nil {
-				return
-			}
+	if params.ParseMode != "" {
+		v := params.ParseMode
+		err = writer.WriteField("parse_mode", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ParseMode != "" {
-			v := params.ParseMode
-			err = writer.WriteField("parse_mode", v)
-			if err != nil {
-				return
-			}
+	if len(params.CaptionEntities) != 0 {
+		var bs []byte
+		bs, err = json.Marshal(&params.CaptionEntities)
+		if err != nil {
+			return
 		}
 
-		if len(params.CaptionEntities) != 0 {
-			var bs []byte
-			bs, err = json.Marshal(&params.CaptionEntities)
-			if err != nil {
-				return
-			}
-
-			var p io.Writer
-			p, err = writer.CreateFormField("caption_entities")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("caption_entities")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ShowCaptionAboveMedia {
-			v := strconv.FormatBool(params.ShowCaptionAboveMedia)
-			err = writer.WriteField("show_caption_above_media", v)
-			if err != nil {
-				return
-			}
+	if params.ShowCaptionAboveMedia {
+		v := strconv.FormatBool(params.ShowCaptionAboveMedia)
+		err = writer.WriteField("show_caption_above_media", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.HasSpoiler {
-			v := strconv.FormatBool(params.HasSpoiler)
-			err = writer.WriteField("has_spoiler", v)
-			if err != nil {
-				return
-			}
+	if params.HasSpoiler {
+		v := strconv.FormatBool(params.HasSpoiler)
+		err = writer.WriteField("has_spoiler", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DisableNotification {
-			v := strconv.FormatBool(params.DisableNotification)
-			err = writer.WriteField("disable_notification", v)
-			if err != nil {
-				return
-			}
+	if params.DisableNotification {
+		v := strconv.FormatBool(params.DisableNotification)
+		err = writer.WriteField("disable_notification", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ProtectContent {
-			v := strconv.FormatBool(params.ProtectContent)
-			err = writer.WriteField("protect_content", v)
-			if err != nil {
-				return
-			}
+	if params.ProtectContent {
+		v := strconv.FormatBool(params.ProtectContent)
+		err = writer.WriteField("protect_content", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.AllowPaidBroadcast {
-			v := strconv.FormatBool(params.AllowPaidBroadcast)
-			err = writer.WriteField("allow_paid_broadcast", v)
-			if err != nil {
-				return
-			}
+	if params.AllowPaidBroadcast {
+		v := strconv.FormatBool(params.AllowPaidBroadcast)
+		err = writer.WriteField("allow_paid_broadcast", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageEffectID != "" {
-			v := params.MessageEffectID
-			err = writer.WriteField("message_effect_id", v)
-			if err != nil {
-				return
-			}
+	if params.MessageEffectID != "" {
+		v := params.MessageEffectID
+		err = writer.WriteField("message_effect_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.SuggestedPostParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.SuggestedPostParameters)
-			if err != nil {
-				return
-			}
+	if params.SuggestedPostParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.SuggestedPostParameters)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("suggested_post_parameters")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("suggested_post_parameters")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReplyParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyParameters)
-			if err != nil {
-				return
-			}
+	if params.ReplyParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyParameters)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_parameters")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_parameters")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReplyMarkup != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyMarkup)
-			if err != nil {
-				return
-			}
+	if params.ReplyMarkup != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyMarkup)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_markup")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_markup")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
+
+	if err = writer.finish(); err != nil {
+		return
+	}
+
+	reader := writer.body()
 
-	}()
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendLivePhoto", reader, contentType, nil)
+	result, err = c.call(ctx, "sendLivePhoto", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -16066,19 +17300,31 @@ func WithSendLocationReplyMarkup(value *ReplyMarkup) SendLocationOption {
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendLocation(ctx context.Context, params *SendLocationParams) (ret *Message, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendLocation", reader, contentType, nil)
+	result, err = c.call(ctx, "sendLocation", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -16247,260 +17493,249 @@ func WithSendMediaGroupReplyParameters(value *ReplyParameters) SendMediaGroupOpt
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendMediaGroup(ctx context.Context, params *SendMediaGroupParams) (ret []Message, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
-	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
-
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
-
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	writer := c.newMultipartForm(ctx, "sendMediaGroup")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
 
-			writeErr <- pw.Close()
-		}()
+	contentType := writer.FormDataContentType()
 
-		if params.BusinessConnectionID != "" {
-			v := params.BusinessConnectionID
-			err = writer.WriteField("business_connection_id", v)
-			if err != nil {
-				return
-			}
+	if params.BusinessConnectionID != "" {
+		v := params.BusinessConnectionID
+		err = writer.WriteField("business_connection_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := params.ChatID
-			err = writer.WriteField("chat_id", v)
-			if err != nil {
-				return
-			}
+	{
+		v := params.ChatID
+		err = writer.WriteField("chat_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageThreadID != 0 {
-			v := strconv.FormatInt(params.MessageThreadID, 10)
-			err = writer.WriteField("message_thread_id", v)
-			if err != nil {
-				return
-			}
+	if params.MessageThreadID != 0 {
+		v := strconv.FormatInt(params.MessageThreadID, 10)
+		err = writer.WriteField("message_thread_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DirectMessagesTopicID != 0 {
-			v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
-			err = writer.WriteField("direct_messages_topic_id", v)
-			if err != nil {
-				return
-			}
+	if params.DirectMessagesTopicID != 0 {
+		v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
+		err = writer.WriteField("direct_messages_topic_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			for i := range params.Media {
-				switch {
-				case params.Media[i].InputMediaAnimation != nil:
-					err = createFormFileFromInputFile(
-						writer,
-						&params.Media[i].InputMediaAnimation.Media,
-						"media_animation_media"+strconv.Itoa(i),
-					)
-					if err != nil {
-						return
-					}
-					if params.Media[i].InputMediaAnimation.Thumbnail != nil {
-						err = createFormFileFromInputFile(
-							writer,
-							params.Media[i].InputMediaAnimation.Thumbnail,
-							"media_animation_thumbnail"+strconv.Itoa(i),
-						)
-						if err != nil {
-							return
-						}
-					}
-				case params.Media[i].InputMediaAudio != nil:
-					err = createFormFileFromInputFile(
-						writer,
-						&params.Media[i].InputMediaAudio.Media,
-						"media_audio_media"+strconv.Itoa(i),
-					)
-					if err != nil {
-						return
-					}
-					if params.Media[i].InputMediaAudio.Thumbnail != nil {
-						err = createFormFileFromInputFile(
-							writer,
-							params.Media[i].InputMediaAudio.Thumbnail,
-							"media_audio_thumbnail"+strconv.Itoa(i),
-						)
-						if err != nil {
-							return
-						}
-					}
-				case params.Media[i].InputMediaDocument != nil:
+	{
+		for i := range params.Media {
+			switch {
+			case params.Media[i].InputMediaAnimation != nil:
+				err = createFormFileFromInputFile(
+					writer,
+					&params.Media[i].InputMediaAnimation.Media,
+					"media_animation_media"+strconv.Itoa(i),
+				)
+				if err != nil {
+					return
+				}
+				if params.Media[i].InputMediaAnimation.Thumbnail != nil {
 					err = createFormFileFromInputFile(
 						writer,
-						&params.Media[i].InputMediaDocument.Media,
-						"media_document_media"+strconv.Itoa(i),
+						params.Media[i].InputMediaAnimation.Thumbnail,
+						"media_animation_thumbnail"+strconv.Itoa(i),
 					)
 					if err != nil {
 						return
 					}
-					if params.Media[i].InputMediaDocument.Thumbnail != nil {
-						err = createFormFileFromInputFile(
-							writer,
-							params.Media[i].InputMediaDocument.Thumbnail,
-							"media_document_thumbnail"+strconv.Itoa(i),
-						)
-						if err != nil {
-							return
-						}
-					}
-				case params.Media[i].InputMediaLivePhoto != nil:
+				}
+			case params.Media[i].InputMediaAudio != nil:
+				err = createFormFileFromInputFile(
+					writer,
+					&params.Media[i].InputMediaAudio.Media,
+					"media_audio_media"+strconv.Itoa(i),
+				)
+				if err != nil {
+					return
+				}
+				if params.Media[i].InputMediaAudio.Thumbnail != nil {
 					err = createFormFileFromInputFile(
 						writer,
-						&params.Media[i].InputMediaLivePhoto.Media,
-						"media_live_photo_media"+strconv.Itoa(i),
+						params.Media[i].InputMediaAudio.Thumbnail,
+						"media_audio_thumbnail"+strconv.Itoa(i),
 					)
 					if err != nil {
 						return
 					}
+				}
+			case params.Media[i].InputMediaDocument != nil:
+				err = createFormFileFromInputFile(
+					writer,
+					&params.Media[i].InputMediaDocument.Media,
+					"media_document_media"+strconv.Itoa(i),
+				)
+				if err != nil {
+					return
+				}
+				if params.Media[i].InputMediaDocument.Thumbnail != nil {
 					err = createFormFileFromInputFile(
 						writer,
-						&params.Media[i].InputMediaLivePhoto.Photo,
-						"media_live_photo_photo"+strconv.Itoa(i),
+						params.Media[i].InputMediaDocument.Thumbnail,
+						"media_document_thumbnail"+strconv.Itoa(i),
 					)
 					if err != nil {
 						return
 					}
-				case params.Media[i].InputMediaPhoto != nil:
+				}
+			case params.Media[i].InputMediaLivePhoto != nil:
+				err = createFormFileFromInputFile(
+					writer,
+					&params.Media[i].InputMediaLivePhoto.Media,
+					"media_live_photo_media"+strconv.Itoa(i),
+				)
+				if err != nil {
+					return
+				}
+				err = createFormFileFromInputFile(
+					writer,
+					&params.Media[i].InputMediaLivePhoto.Photo,
+					"media_live_photo_photo"+strconv.Itoa(i),
+				)
+				if err != nil {
+					return
+				}
+			case params.Media[i].InputMediaPhoto != nil:
+				err = createFormFileFromInputFile(
+					writer,
+					&params.Media[i].InputMediaPhoto.Media,
+					"media_photo_media"+strconv.Itoa(i),
+				)
+				if err != nil {
+					return
+				}
+			case params.Media[i].InputMediaVideo != nil:
+				err = createFormFileFromInputFile(
+					writer,
+					&params.Media[i].InputMediaVideo.Media,
+					"media_video_media"+strconv.Itoa(i),
+				)
+				if err != nil {
+					return
+				}
+				if params.Media[i].InputMediaVideo.Thumbnail != nil {
 					err = createFormFileFromInputFile(
 						writer,
-						&params.Media[i].InputMediaPhoto.Media,
-						"media_photo_media"+strconv.Itoa(i),
+						params.Media[i].InputMediaVideo.Thumbnail,
+						"media_video_thumbnail"+strconv.Itoa(i),
 					)
 					if err != nil {
 						return
 					}
-				case params.Media[i].InputMediaVideo != nil:
+				}
+				if params.Media[i].InputMediaVideo.Cover != nil {
 					err = createFormFileFromInputFile(
 						writer,
-						&params.Media[i].InputMediaVideo.Media,
-						"media_video_media"+strconv.Itoa(i),
+						params.Media[i].InputMediaVideo.Cover,
+						"media_video_cover"+strconv.Itoa(i),
 					)
 					if err != nil {
 						return
 					}
-					if params.Media[i].InputMediaVideo.Thumbnail != nil {
-						err = createFormFileFromInputFile(
-							writer,
-							params.Media[i].InputMediaVideo.Thumbnail,
-							"media_video_thumbnail"+strconv.Itoa(i),
-						)
-						if err != nil {
-							return
-						}
-					}
-					if params.Media[i].InputMediaVideo.Cover != nil {
-						err = createFormFileFromInputFile(
-							writer,
-							params.Media[i].InputMediaVideo.Cover,
-							"media_video_cover"+strconv.Itoa(i),
-						)
-						if err != nil {
-							return
-						}
-					}
 				}
 			}
+		}
 
-			var bs []byte
-			bs, err = json.Marshal(&params.Media)
-			if err != nil {
-				return
-			}
+		var bs []byte
+		bs, err = json.Marshal(&params.Media)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("media")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("media")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DisableNotification {
-			v := strconv.FormatBool(params.DisableNotification)
-			err = writer.WriteField("disable_notification", v)
-			if err != nil {
-				return
-			}
+	if params.DisableNotification {
+		v := strconv.FormatBool(params.DisableNotification)
+		err = writer.WriteField("disable_notification", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ProtectContent {
-			v := strconv.FormatBool(params.ProtectContent)
-			err = writer.WriteField("protect_content", v)
-			if err != nil {
-				return
-			}
+	if params.ProtectContent {
+		v := strconv.FormatBool(params.ProtectContent)
+		err = writer.WriteField("protect_content", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.AllowPaidBroadcast {
-			v := strconv.FormatBool(params.AllowPaidBroadcast)
-			err = writer.WriteField("allow_paid_broadcast", v)
-			if err != nil {
-				return
-			}
+	if params.AllowPaidBroadcast {
+		v := strconv.FormatBool(params.AllowPaidBroadcast)
+		err = writer.WriteField("allow_paid_broadcast", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageEffectID != "" {
-			v := params.MessageEffectID
-			err = writer.WriteField("message_effect_id", v)
-			if err != nil {
-				return
-			}
+	if params.MessageEffectID != "" {
+		v := params.MessageEffectID
+		err = writer.WriteField("message_effect_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReplyParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyParameters)
-			if err != nil {
-				return
-			}
+	if params.ReplyParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyParameters)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_parameters")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_parameters")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
+
+	if err = writer.finish(); err != nil {
+		return
+	}
 
-	}()
+	reader := writer.body()
+
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendMediaGroup", reader, contentType, nil)
+	result, err = c.call(ctx, "sendMediaGroup", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -16776,19 +18011,31 @@ func WithSendMessageReplyMarkup(value *ReplyMarkup) SendMessageOption {
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendMessage(ctx context.Context, params *SendMessageParams) (ret *Message, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendMessage", reader, contentType, nil)
+	result, err = c.call(ctx, "sendMessage", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -16907,19 +18154,31 @@ func WithSendMessageDraftEntities(value []MessageEntity) SendMessageDraftOption
 //
 // [sendMessage]: https://core.telegram.org/bots/api#sendmessage
 func (c *Client) SendMessageDraft(ctx context.Context, params *SendMessageDraftParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendMessageDraft", reader, contentType, nil)
+	result, err = c.call(ctx, "sendMessageDraft", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -17192,292 +18451,281 @@ func WithSendPaidMediaReplyMarkup(value *ReplyMarkup) SendPaidMediaOption {
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendPaidMedia(ctx context.Context, params *SendPaidMediaParams) (ret *Message, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
-	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
-
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
-
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	writer := c.newMultipartForm(ctx, "sendPaidMedia")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
 
-			writeErr <- pw.Close()
-		}()
+	contentType := writer.FormDataContentType()
 
-		if params.BusinessConnectionID != "" {
-			v := params.BusinessConnectionID
-			err = writer.WriteField("business_connection_id", v)
-			if err != nil {
-				return
-			}
+	if params.BusinessConnectionID != "" {
+		v := params.BusinessConnectionID
+		err = writer.WriteField("business_connection_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := params.ChatID
-			err = writer.WriteField("chat_id", v)
-			if err != nil {
-				return
-			}
+	{
+		v := params.ChatID
+		err = writer.WriteField("chat_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageThreadID != 0 {
-			v := strconv.FormatInt(params.MessageThreadID, 10)
-			err = writer.WriteField("message_thread_id", v)
-			if err != nil {
-				return
-			}
+	if params.MessageThreadID != 0 {
+		v := strconv.FormatInt(params.MessageThreadID, 10)
+		err = writer.WriteField("message_thread_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DirectMessagesTopicID != 0 {
-			v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
-			err = writer.WriteField("direct_messages_topic_id", v)
-			if err != nil {
-				return
-			}
+	if params.DirectMessagesTopicID != 0 {
+		v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
+		err = writer.WriteField("direct_messages_topic_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := strconv.FormatInt(params.StarCount, 10)
-			err = writer.WriteField("star_count", v)
-			if err != nil {
-				return
-			}
+	{
+		v := strconv.FormatInt(params.StarCount, 10)
+		err = writer.WriteField("star_count", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			for i := range params.Media {
-				switch {
-				case params.Media[i].InputPaidMediaLivePhoto != nil:
-					err = createFormFileFromInputFile(
-						writer,
-						&params.Media[i].InputPaidMediaLivePhoto.Media,
-						"media_live_photo_media"+strconv.Itoa(i),
-					)
-					if err != nil {
-						return
-					}
-					err = createFormFileFromInputFile(
-						writer,
-						&params.Media[i].InputPaidMediaLivePhoto.Photo,
-						"media_live_photo_photo"+strconv.Itoa(i),
-					)
-					if err != nil {
-						return
-					}
-				case params.Media[i].InputPaidMediaPhoto != nil:
+	{
+		for i := range params.Media {
+			switch {
+			case params.Media[i].InputPaidMediaLivePhoto != nil:
+				err = createFormFileFromInputFile(
+					writer,
+					&params.Media[i].InputPaidMediaLivePhoto.Media,
+					"media_live_photo_media"+strconv.Itoa(i),
+				)
+				if err != nil {
+					return
+				}
+				err = createFormFileFromInputFile(
+					writer,
+					&params.Media[i].InputPaidMediaLivePhoto.Photo,
+					"media_live_photo_photo"+strconv.Itoa(i),
+				)
+				if err != nil {
+					return
+				}
+			case params.Media[i].InputPaidMediaPhoto != nil:
+				err = createFormFileFromInputFile(
+					writer,
+					&params.Media[i].InputPaidMediaPhoto.Media,
+					"media_photo_media"+strconv.Itoa(i),
+				)
+				if err != nil {
+					return
+				}
+			case params.Media[i].InputPaidMediaVideo != nil:
+				err = createFormFileFromInputFile(
+					writer,
+					&params.Media[i].InputPaidMediaVideo.Media,
+					"media_video_media"+strconv.Itoa(i),
+				)
+				if err != nil {
+					return
+				}
+				if params.Media[i].InputPaidMediaVideo.Thumbnail != nil {
 					err = createFormFileFromInputFile(
 						writer,
-						&params.Media[i].InputPaidMediaPhoto.Media,
-						"media_photo_media"+strconv.Itoa(i),
+						params.Media[i].InputPaidMediaVideo.Thumbnail,
+						"media_video_thumbnail"+strconv.Itoa(i),
 					)
 					if err != nil {
 						return
 					}
-				case params.Media[i].InputPaidMediaVideo != nil:
+				}
+				if params.Media[i].InputPaidMediaVideo.Cover != nil {
 					err = createFormFileFromInputFile(
 						writer,
-						&params.Media[i].InputPaidMediaVideo.Media,
-						"media_video_media"+strconv.Itoa(i),
+						params.Media[i].InputPaidMediaVideo.Cover,
+						"media_video_cover"+strconv.Itoa(i),
 					)
 					if err != nil {
 						return
 					}
-					if params.Media[i].InputPaidMediaVideo.Thumbnail != nil {
-						err = createFormFileFromInputFile(
-							writer,
-							params.Media[i].InputPaidMediaVideo.Thumbnail,
-							"media_video_thumbnail"+strconv.Itoa(i),
-						)
-						if err != nil {
-							return
-						}
-					}
-					if params.Media[i].InputPaidMediaVideo.Cover != nil {
-						err = createFormFileFromInputFile(
-							writer,
-							params.Media[i].InputPaidMediaVideo.Cover,
-							"media_video_cover"+strconv.Itoa(i),
-						)
-						if err != nil {
-							return
-						}
-					}
 				}
 			}
+		}
 
-			var bs []byte
-			bs, err = json.Marshal(&params.Media)
-			if err != nil {
-				return
-			}
+		var bs []byte
+		bs, err = json.Marshal(&params.Media)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("media")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("media")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Payload != "" {
-			v := params.Payload
-			err = writer.WriteField("payload", v)
-			if err != nil {
-				return
-			}
+	if params.Payload != "" {
+		v := params.Payload
+		err = writer.WriteField("payload", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Caption != "" {
-			v := params.Caption
-			err = writer.WriteField("caption", v)
-			if err != nil {
-				return
-			}
+	if params.Caption != "" {
+		v := params.Caption
+		err = writer.WriteField("caption", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ParseMode != "" {
-			v := params.ParseMode
-			err = writer.WriteField("parse_mode", v)
-			if err != nil {
-				return
-			}
+	if params.ParseMode != "" {
+		v := params.ParseMode
+		err = writer.WriteField("parse_mode", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if len(params.CaptionEntities) != 0 {
-			var bs []byte
-			bs, err = json.Marshal(&params.CaptionEntities)
-			if err != nil {
-				return
-			}
+	if len(params.CaptionEntities) != 0 {
+		var bs []byte
+		bs, err = json.Marshal(&params.CaptionEntities)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("caption_entities")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("caption_entities")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ShowCaptionAboveMedia {
-			v := strconv.FormatBool(params.ShowCaptionAboveMedia)
-			err = writer.WriteField("show_caption_above_media", v)
-			if err != nil {
-				return
-			}
+	if params.ShowCaptionAboveMedia {
+		v := strconv.FormatBool(params.ShowCaptionAboveMedia)
+		err = writer.WriteField("show_caption_above_media", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DisableNotification {
-			v := strconv.FormatBool(params.DisableNotification)
-			err = writer.WriteField("disable_notification", v)
-			if err != nil {
-				return
-			}
+	if params.DisableNotification {
+		v := strconv.FormatBool(params.DisableNotification)
+		err = writer.WriteField("disable_notification", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ProtectContent {
-			v := strconv.FormatBool(params.ProtectContent)
-			err = writer.WriteField("protect_content", v)
-			if err != nil {
-				return
-			}
+	if params.ProtectContent {
+		v := strconv.FormatBool(params.ProtectContent)
+		err = writer.WriteField("protect_content", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.AllowPaidBroadcast {
-			v := strconv.FormatBool(params.AllowPaidBroadcast)
-			err = writer.WriteField("allow_paid_broadcast", v)
-			if err != nil {
-				return
-			}
+	if params.AllowPaidBroadcast {
+		v := strconv.FormatBool(params.AllowPaidBroadcast)
+		err = writer.WriteField("allow_paid_broadcast", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.SuggestedPostParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.SuggestedPostParameters)
-			if err != nil {
-				return
-			}
+	if params.SuggestedPostParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.SuggestedPostParameters)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("suggested_post_parameters")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("suggested_post_parameters")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReplyParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyParameters)
-			if err != nil {
-				return
-			}
+	if params.ReplyParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyParameters)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_parameters")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_parameters")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReplyMarkup != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyMarkup)
-			if err != nil {
-				return
-			}
+	if params.ReplyMarkup != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyMarkup)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_markup")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_markup")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
+
+	if err = writer.finish(); err != nil {
+		return
+	}
 
-	}()
+	reader := writer.body()
+
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendPaidMedia", reader, contentType, nil)
+	result, err = c.call(ctx, "sendPaidMedia", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -17791,241 +19039,230 @@ func WithSendPhotoReplyMarkup(value *ReplyMarkup) SendPhotoOption {
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendPhoto(ctx context.Context, params *SendPhotoParams) (ret *Message, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
-	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
-
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	writer := c.newMultipartForm(ctx, "sendPhoto")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
 
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	contentType := writer.FormDataContentType()
 
-			writeErr <- pw.Close()
-		}()
+	if params.BusinessConnectionID != "" {
+		v := params.BusinessConnectionID
+		err = writer.WriteField("business_connection_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-		if params.BusinessConnectionID != "" {
-			v := params.BusinessConnectionID
-			err = writer.WriteField("business_connection_id", v)
-			if err != nil {
-				return
-			}
+	{
+		v := params.ChatID
+		err = writer.WriteField("chat_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := params.ChatID
-			err = writer.WriteField("chat_id", v)
-			if err != nil {
-				return
-			}
+	if params.MessageThreadID != 0 {
+		v := strconv.FormatInt(params.MessageThreadID, 10)
+		err = writer.WriteField("message_thread_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageThreadID != 0 {
-			v := strconv.FormatInt(params.MessageThreadID, 10)
-			err = writer.WriteField("message_thread_id", v)
-			if err != nil {
-				return
-			}
+	if params.DirectMessagesTopicID != 0 {
+		v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
+		err = writer.WriteField("direct_messages_topic_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DirectMessagesTopicID != 0 {
-			v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
-			err = writer.WriteField("direct_messages_topic_id", v)
-			if err != nil {
-				return
-			}
+	if params.ReceiverUserID != 0 {
+		v := strconv.FormatInt(params.ReceiverUserID, 10)
+		err = writer.WriteField("receiver_user_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReceiverUserID != 0 {
-			v := strconv.FormatInt(params.ReceiverUserID, 10)
-			err = writer.WriteField("receiver_user_id", v)
-			if err != nil {
-				return
-			}
+	if params.CallbackQueryID != "" {
+		v := params.CallbackQueryID
+		err = writer.WriteField("callback_query_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.CallbackQueryID != "" {
-			v := params.CallbackQueryID
-			err = writer.WriteField("callback_query_id", v)
-			if err != nil {
-				return
-			}
+	{
+		err = writeFormInputFile(
+			writer,
+			&params.Photo,
+			"photo",
+		)
+		if err != nil {
+			return
 		}
 
-		{
-			err = createFormFileFromInputFile(
-				writer,
-				&params.Photo,
-				"photo",
-			)
-			if err != nil {
-				return
-			}
+	}
 
+	if params.Caption != "" {
+		v := params.Caption
+		err = writer.WriteField("caption", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Caption != "" {
-			v := params.Caption
-			err = writer.WriteField("caption", v)
-			if err != nil {
-				return
-			}
+	if params.ParseMode != "" {
+		v := params.ParseMode
+		err = writer.WriteField("parse_mode", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ParseMode != "" {
-			v := params.ParseMode
-			err = writer.WriteField("parse_mode", v)
-			if err != nil {
-				return
-			}
+	if len(params.CaptionEntities) != 0 {
+		var bs []byte
+		bs, err = json.Marshal(&params.CaptionEntities)
+		if err != nil {
+			return
 		}
 
-		if len(params.CaptionEntities) != 0 {
-			var bs []byte
-			bs, err = json.Marshal(&params.CaptionEntities)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("caption_entities")
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("caption_entities")
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+	if params.ShowCaptionAboveMedia {
+		v := strconv.FormatBool(params.ShowCaptionAboveMedia)
+		err = writer.WriteField("show_caption_above_media", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ShowCaptionAboveMedia {
-			v := strconv.FormatBool(params.ShowCaptionAboveMedia)
-			err = writer.WriteField("show_caption_above_media", v)
-			if err != nil {
-				return
-			}
+	if params.HasSpoiler {
+		v := strconv.FormatBool(params.HasSpoiler)
+		err = writer.WriteField("has_spoiler", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.HasSpoiler {
-			v := strconv.FormatBool(params.HasSpoiler)
-			err = writer.WriteField("has_spoiler", v)
-			if err != nil {
-				return
-			}
+	if params.DisableNotification {
+		v := strconv.FormatBool(params.DisableNotification)
+		err = writer.WriteField("disable_notification", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DisableNotification {
-			v := strconv.FormatBool(params.DisableNotification)
-			err = writer.WriteField("disable_notification", v)
-			if err != nil {
-				return
-			}
+	if params.ProtectContent {
+		v := strconv.FormatBool(params.ProtectContent)
+		err = writer.WriteField("protect_content", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ProtectContent {
-			v := strconv.FormatBool(params.ProtectContent)
-			err = writer.WriteField("protect_content", v)
-			if err != nil {
-				return
-			}
+	if params.AllowPaidBroadcast {
+		v := strconv.FormatBool(params.AllowPaidBroadcast)
+		err = writer.WriteField("allow_paid_broadcast", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.AllowPaidBroadcast {
-			v := strconv.FormatBool(params.AllowPaidBroadcast)
-			err = writer.WriteField("allow_paid_broadcast", v)
-			if err != nil {
-				return
-			}
+	if params.MessageEffectID != "" {
+		v := params.MessageEffectID
+		err = writer.WriteField("message_effect_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageEffectID != "" {
-			v := params.MessageEffectID
-			err = writer.WriteField("message_effect_id", v)
-			if err != nil {
-				return
-			}
+	if params.SuggestedPostParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.SuggestedPostParameters)
+		if err != nil {
+			return
 		}
 
-		if params.SuggestedPostParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.SuggestedPostParameters)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("suggested_post_parameters")
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("suggested_post_parameters")
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+	if params.ReplyParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyParameters)
+		if err != nil {
+			return
 		}
 
-		if params.ReplyParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyParameters)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_parameters")
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_parameters")
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+	if params.ReplyMarkup != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyMarkup)
+		if err != nil {
+			return
 		}
 
-		if params.ReplyMarkup != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyMarkup)
-			if err != nil {
-				return
-			}
-
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_markup")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_markup")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-	}()
+	if err = writer.finish(); err != nil {
+		return
+	}
+
+	reader := writer.body()
+
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendPhoto", reader, contentType, nil)
+	result, err = c.call(ctx, "sendPhoto", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -18531,657 +19768,646 @@ func WithSendPollReplyMarkup(value *ReplyMarkup) SendPollOption {
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendPoll(ctx context.Context, params *SendPollParams) (ret *Message, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
-	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
+	writer := c.newMultipartForm(ctx, "sendPoll")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
 
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
-
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	contentType := writer.FormDataContentType()
 
-			writeErr <- pw.Close()
-		}()
+	if params.BusinessConnectionID != "" {
+		v := params.BusinessConnectionID
+		err = writer.WriteField("business_connection_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-		if params.BusinessConnectionID != "" {
-			v := params.BusinessConnectionID
-			err = writer.WriteField("business_connection_id", v)
-			if err != nil {
-				return
-			}
+	{
+		v := params.ChatID
+		err = writer.WriteField("chat_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := params.ChatID
-			err = writer.WriteField("chat_id", v)
-			if err != nil {
-				return
-			}
+	if params.MessageThreadID != 0 {
+		v := strconv.FormatInt(params.MessageThreadID, 10)
+		err = writer.WriteField("message_thread_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageThreadID != 0 {
-			v := strconv.FormatInt(params.MessageThreadID, 10)
-			err = writer.WriteField("message_thread_id", v)
-			if err != nil {
-				return
-			}
+	{
+		v := params.Question
+		err = writer.WriteField("question", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := params.Question
-			err = writer.WriteField("question", v)
-			if err != nil {
-				return
-			}
+	if params.QuestionParseMode != "" {
+		v := params.QuestionParseMode
+		err = writer.WriteField("question_parse_mode", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.QuestionParseMode != "" {
-			v := params.QuestionParseMode
-			err = writer.WriteField("question_parse_mode", v)
-			if err != nil {
-				return
-			}
+	if len(params.QuestionEntities) != 0 {
+		var bs []byte
+		bs, err = json.Marshal(&params.QuestionEntities)
+		if err != nil {
+			return
 		}
 
-		if len(params.QuestionEntities) != 0 {
-			var bs []byte
-			bs, err = json.Marshal(&params.QuestionEntities)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("question_entities")
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("question_entities")
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+	{
+		var bs []byte
+		bs, err = json.Marshal(&params.Options)
+		if err != nil {
+			return
 		}
 
-		{
-			var bs []byte
-			bs, err = json.Marshal(&params.Options)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("options")
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("options")
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+	if params.IsAnonymous {
+		v := strconv.FormatBool(params.IsAnonymous)
+		err = writer.WriteField("is_anonymous", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.IsAnonymous {
-			v := strconv.FormatBool(params.IsAnonymous)
-			err = writer.WriteField("is_anonymous", v)
-			if err != nil {
-				return
-			}
+	if params.Type != "" {
+		v := params.Type
+		err = writer.WriteField("type", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Type != "" {
-			v := params.Type
-			err = writer.WriteField("type", v)
-			if err != nil {
-				return
-			}
+	if params.AllowsMultipleAnswers {
+		v := strconv.FormatBool(params.AllowsMultipleAnswers)
+		err = writer.WriteField("allows_multiple_answers", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.AllowsMultipleAnswers {
-			v := strconv.FormatBool(params.AllowsMultipleAnswers)
-			err = writer.WriteField("allows_multiple_answers", v)
-			if err != nil {
-				return
-			}
+	if params.AllowsRevoting {
+		v := strconv.FormatBool(params.AllowsRevoting)
+		err = writer.WriteField("allows_revoting", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.AllowsRevoting {
-			v := strconv.FormatBool(params.AllowsRevoting)
-			err = writer.WriteField("allows_revoting", v)
-			if err != nil {
-				return
-			}
+	if params.ShuffleOptions {
+		v := strconv.FormatBool(params.ShuffleOptions)
+		err = writer.WriteField("shuffle_options", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ShuffleOptions {
-			v := strconv.FormatBool(params.ShuffleOptions)
-			err = writer.WriteField("shuffle_options", v)
-			if err != nil {
-				return
-			}
+	if params.AllowAddingOptions {
+		v := strconv.FormatBool(params.AllowAddingOptions)
+		err = writer.WriteField("allow_adding_options", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.AllowAddingOptions {
-			v := strconv.FormatBool(params.AllowAddingOptions)
-			err = writer.WriteField("allow_adding_options", v)
-			if err != nil {
-				return
-			}
+	if params.HideResultsUntilCloses {
+		v := strconv.FormatBool(params.HideResultsUntilCloses)
+		err = writer.WriteField("hide_results_until_closes", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.HideResultsUntilCloses {
-			v := strconv.FormatBool(params.HideResultsUntilCloses)
-			err = writer.WriteField("hide_results_until_closes", v)
-			if err != nil {
-				return
-			}
+	if params.MembersOnly {
+		v := strconv.FormatBool(params.MembersOnly)
+		err = writer.WriteField("members_only", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MembersOnly {
-			v := strconv.FormatBool(params.MembersOnly)
-			err = writer.WriteField("members_only", v)
-			if err != nil {
-				return
-			}
+	if len(params.CountryCodes) != 0 {
+		var bs []byte
+		bs, err = json.Marshal(&params.CountryCodes)
+		if err != nil {
+			return
 		}
 
-		if len(params.CountryCodes) != 0 {
-			var bs []byte
-			bs, err = json.Marshal(&params.CountryCodes)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("country_codes")
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("country_codes")
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+	if len(params.CorrectOptionIDs) != 0 {
+		var bs []byte
+		bs, err = json.Marshal(&params.CorrectOptionIDs)
+		if err != nil {
+			return
 		}
 
-		if len(params.CorrectOptionIDs) != 0 {
-			var bs []byte
-			bs, err = json.Marshal(&params.CorrectOptionIDs)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("correct_option_ids")
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("correct_option_ids")
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+	if params.Explanation != "" {
+		v := params.Explanation
+		err = writer.WriteField("explanation", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Explanation != "" {
-			v := params.Explanation
-			err = writer.WriteField("explanation", v)
-			if err != nil {
-				return
-			}
+	if params.ExplanationParseMode != "" {
+		v := params.ExplanationParseMode
+		err = writer.WriteField("explanation_parse_mode", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ExplanationParseMode != "" {
-			v := params.ExplanationParseMode
-			err = writer.WriteField("explanation_parse_mode", v)
-			if err != nil {
-				return
-			}
+	if len(params.ExplanationEntities) != 0 {
+		var bs []byte
+		bs, err = json.Marshal(&params.ExplanationEntities)
+		if err != nil {
+			return
 		}
 
-		if len(params.ExplanationEntities) != 0 {
-			var bs []byte
-			bs, err = json.Marshal(&params.ExplanationEntities)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("explanation_entities")
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("explanation_entities")
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			_, err = p.Write(bs)
+	{
+		switch {
+		case params.ExplanationMedia.InputMediaAnimation != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.ExplanationMedia.InputMediaAnimation.Media,
+				"explanation_media_animation_media",
+			)
 			if err != nil {
 				return
 			}
-		}
-
-		{
-			switch {
-			case params.ExplanationMedia.InputMediaAnimation != nil:
-				err = createFormFileFromInputFile(
-					writer,
-					&params.ExplanationMedia.InputMediaAnimation.Media,
-					"explanation_media_animation_media",
-				)
-				if err != nil {
-					return
-				}
-				if params.ExplanationMedia.InputMediaAnimation.Thumbnail != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.ExplanationMedia.InputMediaAnimation.Thumbnail,
-						"explanation_media_animation_thumbnail",
-					)
-					if err != nil {
-						return
-					}
-				}
-			case params.ExplanationMedia.InputMediaAudio != nil:
+			if params.ExplanationMedia.InputMediaAnimation.Thumbnail != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.ExplanationMedia.InputMediaAudio.Media,
-					"explanation_media_audio_media",
+					params.ExplanationMedia.InputMediaAnimation.Thumbnail,
+					"explanation_media_animation_thumbnail",
 				)
 				if err != nil {
 					return
 				}
-				if params.ExplanationMedia.InputMediaAudio.Thumbnail != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.ExplanationMedia.InputMediaAudio.Thumbnail,
-						"explanation_media_audio_thumbnail",
-					)
-					if err != nil {
-						return
-					}
-				}
-			case params.ExplanationMedia.InputMediaDocument != nil:
-				err = createFormFileFromInputFile(
-					writer,
-					&params.ExplanationMedia.InputMediaDocument.Media,
-					"explanation_media_document_media",
-				)
-				if err != nil {
-					return
-				}
-				if params.ExplanationMedia.InputMediaDocument.Thumbnail != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.ExplanationMedia.InputMediaDocument.Thumbnail,
-						"explanation_media_document_thumbnail",
-					)
-					if err != nil {
-						return
-					}
-				}
-			case params.ExplanationMedia.InputMediaLivePhoto != nil:
+			}
+		case params.ExplanationMedia.InputMediaAudio != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.ExplanationMedia.InputMediaAudio.Media,
+				"explanation_media_audio_media",
+			)
+			if err != nil {
+				return
+			}
+			if params.ExplanationMedia.InputMediaAudio.Thumbnail != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.ExplanationMedia.InputMediaLivePhoto.Media,
-					"explanation_media_live_photo_media",
+					params.ExplanationMedia.InputMediaAudio.Thumbnail,
+					"explanation_media_audio_thumbnail",
 				)
 				if err != nil {
 					return
 				}
+			}
+		case params.ExplanationMedia.InputMediaDocument != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.ExplanationMedia.InputMediaDocument.Media,
+				"explanation_media_document_media",
+			)
+			if err != nil {
+				return
+			}
+			if params.ExplanationMedia.InputMediaDocument.Thumbnail != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.ExplanationMedia.InputMediaLivePhoto.Photo,
-					"explanation_media_live_photo_photo",
+					params.ExplanationMedia.InputMediaDocument.Thumbnail,
+					"explanation_media_document_thumbnail",
 				)
 				if err != nil {
 					return
 				}
-			case params.ExplanationMedia.InputMediaLocation != nil:
-			case params.ExplanationMedia.InputMediaPhoto != nil:
+			}
+		case params.ExplanationMedia.InputMediaLivePhoto != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.ExplanationMedia.InputMediaLivePhoto.Media,
+				"explanation_media_live_photo_media",
+			)
+			if err != nil {
+				return
+			}
+			err = createFormFileFromInputFile(
+				writer,
+				&params.ExplanationMedia.InputMediaLivePhoto.Photo,
+				"explanation_media_live_photo_photo",
+			)
+			if err != nil {
+				return
+			}
+		case params.ExplanationMedia.InputMediaLocation != nil:
+		case params.ExplanationMedia.InputMediaPhoto != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.ExplanationMedia.InputMediaPhoto.Media,
+				"explanation_media_photo_media",
+			)
+			if err != nil {
+				return
+			}
+		case params.ExplanationMedia.InputMediaVenue != nil:
+		case params.ExplanationMedia.InputMediaVideo != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.ExplanationMedia.InputMediaVideo.Media,
+				"explanation_media_video_media",
+			)
+			if err != nil {
+				return
+			}
+			if params.ExplanationMedia.InputMediaVideo.Thumbnail != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.ExplanationMedia.InputMediaPhoto.Media,
-					"explanation_media_photo_media",
+					params.ExplanationMedia.InputMediaVideo.Thumbnail,
+					"explanation_media_video_thumbnail",
 				)
 				if err != nil {
 					return
 				}
-			case params.ExplanationMedia.InputMediaVenue != nil:
-			case params.ExplanationMedia.InputMediaVideo != nil:
+			}
+			if params.ExplanationMedia.InputMediaVideo.Cover != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.ExplanationMedia.InputMediaVideo.Media,
-					"explanation_media_video_media",
+					params.ExplanationMedia.InputMediaVideo.Cover,
+					"explanation_media_video_cover",
 				)
 				if err != nil {
 					return
 				}
-				if params.ExplanationMedia.InputMediaVideo.Thumbnail != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.ExplanationMedia.InputMediaVideo.Thumbnail,
-						"explanation_media_video_thumbnail",
-					)
-					if err != nil {
-						return
-					}
-				}
-				if params.ExplanationMedia.InputMediaVideo.Cover != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.ExplanationMedia.InputMediaVideo.Cover,
-						"explanation_media_video_cover",
-					)
-					if err != nil {
-						return
-					}
-				}
 			}
+		}
 
-			var bs []byte
-			bs, err = json.Marshal(&params.ExplanationMedia)
-			if err != nil {
-				return
-			}
+		var bs []byte
+		bs, err = json.Marshal(&params.ExplanationMedia)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("explanation_media")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("explanation_media")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
+
+	if params.OpenPeriod != 0 {
+		v := strconv.FormatInt(params.OpenPeriod, 10)
+		err = writer.WriteField("open_period", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.OpenPeriod != 0 {
-			v := strconv.FormatInt(params.OpenPeriod, 10)
-			err = writer.WriteField("open_period", v)
-			if err != nil {
-				return
-			}
+	if params.CloseDate != 0 {
+		v := strconv.FormatInt(params.CloseDate, 10)
+		err = writer.WriteField("close_date", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.CloseDate != 0 {
-			v := strconv.FormatInt(params.CloseDate, 10)
-			err = writer.WriteField("close_date", v)
-			if err != nil {
-				return
-			}
+	if params.IsClosed {
+		v := strconv.FormatBool(params.IsClosed)
+		err = writer.WriteField("is_closed", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.IsClosed {
-			v := strconv.FormatBool(params.IsClosed)
-			err = writer.WriteField("is_closed", v)
-			if err != nil {
-				return
-			}
+	if params.Description != "" {
+		v := params.Description
+		err = writer.WriteField("description", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Description != "" {
-			v := params.Description
-			err = writer.WriteField("description", v)
-			if err != nil {
-				return
-			}
+	if params.DescriptionParseMode != "" {
+		v := params.DescriptionParseMode
+		err = writer.WriteField("description_parse_mode", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DescriptionParseMode != "" {
-			v := params.DescriptionParseMode
-			err = writer.WriteField("description_parse_mode", v)
-			if err != nil {
-				return
-			}
+	if len(params.DescriptionEntities) != 0 {
+		var bs []byte
+		bs, err = json.Marshal(&params.DescriptionEntities)
+		if err != nil {
+			return
 		}
 
-		if len(params.DescriptionEntities) != 0 {
-			var bs []byte
-			bs, err = json.Marshal(&params.DescriptionEntities)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("description_entities")
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("description_entities")
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			_, err = p.Write(bs)
+	{
+		switch {
+		case params.Media.InputMediaAnimation != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaAnimation.Media,
+				"media_animation_media",
+			)
 			if err != nil {
 				return
 			}
-		}
-
-		{
-			switch {
-			case params.Media.InputMediaAnimation != nil:
-				err = createFormFileFromInputFile(
-					writer,
-					&params.Media.InputMediaAnimation.Media,
-					"media_animation_media",
-				)
-				if err != nil {
-					return
-				}
-				if params.Media.InputMediaAnimation.Thumbnail != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.Media.InputMediaAnimation.Thumbnail,
-						"media_animation_thumbnail",
-					)
-					if err != nil {
-						return
-					}
-				}
-			case params.Media.InputMediaAudio != nil:
-				err = createFormFileFromInputFile(
-					writer,
-					&params.Media.InputMediaAudio.Media,
-					"media_audio_media",
-				)
-				if err != nil {
-					return
-				}
-				if params.Media.InputMediaAudio.Thumbnail != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.Media.InputMediaAudio.Thumbnail,
-						"media_audio_thumbnail",
-					)
-					if err != nil {
-						return
-					}
-				}
-			case params.Media.InputMediaDocument != nil:
+			if params.Media.InputMediaAnimation.Thumbnail != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.Media.InputMediaDocument.Media,
-					"media_document_media",
+					params.Media.InputMediaAnimation.Thumbnail,
+					"media_animation_thumbnail",
 				)
 				if err != nil {
 					return
 				}
-				if params.Media.InputMediaDocument.Thumbnail != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.Media.InputMediaDocument.Thumbnail,
-						"media_document_thumbnail",
-					)
-					if err != nil {
-						return
-					}
-				}
-			case params.Media.InputMediaLivePhoto != nil:
+			}
+		case params.Media.InputMediaAudio != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaAudio.Media,
+				"media_audio_media",
+			)
+			if err != nil {
+				return
+			}
+			if params.Media.InputMediaAudio.Thumbnail != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.Media.InputMediaLivePhoto.Media,
-					"media_live_photo_media",
+					params.Media.InputMediaAudio.Thumbnail,
+					"media_audio_thumbnail",
 				)
 				if err != nil {
 					return
 				}
+			}
+		case params.Media.InputMediaDocument != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaDocument.Media,
+				"media_document_media",
+			)
+			if err != nil {
+				return
+			}
+			if params.Media.InputMediaDocument.Thumbnail != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.Media.InputMediaLivePhoto.Photo,
-					"media_live_photo_photo",
+					params.Media.InputMediaDocument.Thumbnail,
+					"media_document_thumbnail",
 				)
 				if err != nil {
 					return
 				}
-			case params.Media.InputMediaLocation != nil:
-			case params.Media.InputMediaPhoto != nil:
+			}
+		case params.Media.InputMediaLivePhoto != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaLivePhoto.Media,
+				"media_live_photo_media",
+			)
+			if err != nil {
+				return
+			}
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaLivePhoto.Photo,
+				"media_live_photo_photo",
+			)
+			if err != nil {
+				return
+			}
+		case params.Media.InputMediaLocation != nil:
+		case params.Media.InputMediaPhoto != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaPhoto.Media,
+				"media_photo_media",
+			)
+			if err != nil {
+				return
+			}
+		case params.Media.InputMediaVenue != nil:
+		case params.Media.InputMediaVideo != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Media.InputMediaVideo.Media,
+				"media_video_media",
+			)
+			if err != nil {
+				return
+			}
+			if params.Media.InputMediaVideo.Thumbnail != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.Media.InputMediaPhoto.Media,
-					"media_photo_media",
+					params.Media.InputMediaVideo.Thumbnail,
+					"media_video_thumbnail",
 				)
 				if err != nil {
 					return
 				}
-			case params.Media.InputMediaVenue != nil:
-			case params.Media.InputMediaVideo != nil:
+			}
+			if params.Media.InputMediaVideo.Cover != nil {
 				err = createFormFileFromInputFile(
 					writer,
-					&params.Media.InputMediaVideo.Media,
-					"media_video_media",
+					params.Media.InputMediaVideo.Cover,
+					"media_video_cover",
 				)
 				if err != nil {
 					return
 				}
-				if params.Media.InputMediaVideo.Thumbnail != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.Media.InputMediaVideo.Thumbnail,
-						"media_video_thumbnail",
-					)
-					if err != nil {
-						return
-					}
-				}
-				if params.Media.InputMediaVideo.Cover != nil {
-					err = createFormFileFromInputFile(
-						writer,
-						params.Media.InputMediaVideo.Cover,
-						"media_video_cover",
-					)
-					if err != nil {
-						return
-					}
-				}
 			}
+		}
 
-			var bs []byte
-			bs, err = json.Marshal(&params.Media)
-			if err != nil {
-				return
-			}
+		var bs []byte
+		bs, err = json.Marshal(&params.Media)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("media")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("media")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DisableNotification {
-			v := strconv.FormatBool(params.DisableNotification)
-			err = writer.WriteField("disable_notification", v)
-			if err != nil {
-				return
-			}
+	if params.DisableNotification {
+		v := strconv.FormatBool(params.DisableNotification)
+		err = writer.WriteField("disable_notification", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ProtectContent {
-			v := strconv.FormatBool(params.ProtectContent)
-			err = writer.WriteField("protect_content", v)
-			if err != nil {
-				return
-			}
+	if params.ProtectContent {
+		v := strconv.FormatBool(params.ProtectContent)
+		err = writer.WriteField("protect_content", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.AllowPaidBroadcast {
-			v := strconv.FormatBool(params.AllowPaidBroadcast)
-			err = writer.WriteField("allow_paid_broadcast", v)
-			if err != nil {
-				return
-			}
+	if params.AllowPaidBroadcast {
+		v := strconv.FormatBool(params.AllowPaidBroadcast)
+		err = writer.WriteField("allow_paid_broadcast", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageEffectID != "" {
-			v := params.MessageEffectID
-			err = writer.WriteField("message_effect_id", v)
-			if err != nil {
-				return
-			}
+	if params.MessageEffectID != "" {
+		v := params.MessageEffectID
+		err = writer.WriteField("message_effect_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReplyParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyParameters)
-			if err != nil {
-				return
-			}
+	if params.ReplyParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyParameters)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_parameters")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_parameters")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReplyMarkup != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyMarkup)
-			if err != nil {
-				return
-			}
+	if params.ReplyMarkup != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyMarkup)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_markup")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_markup")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
+
+	if err = writer.finish(); err != nil {
+		return
+	}
 
-	}()
+	reader := writer.body()
+
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendPoll", reader, contentType, nil)
+	result, err = c.call(ctx, "sendPoll", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -19386,19 +20612,31 @@ func WithSendRichMessageReplyMarkup(value *ReplyMarkup) SendRichMessageOption {
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendRichMessage(ctx context.Context, params *SendRichMessageParams) (ret *Message, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendRichMessage", reader, contentType, nil)
+	result, err = c.call(ctx, "sendRichMessage", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -19487,19 +20725,31 @@ func WithSendRichMessageDraftRichMessage(value InputRichMessage) SendRichMessage
 //
 // [sendRichMessage]: https://core.telegram.org/bots/api#sendrichmessage
 func (c *Client) SendRichMessageDraft(ctx context.Context, params *SendRichMessageDraftParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendRichMessageDraft", reader, contentType, nil)
+	result, err = c.call(ctx, "sendRichMessageDraft", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -19756,198 +21006,187 @@ func WithSendStickerReplyMarkup(value *ReplyMarkup) SendStickerOption {
 // [video]: https://telegram.org/blog/video-stickers-better-reactions
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendSticker(ctx context.Context, params *SendStickerParams) (ret *Message, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
-	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
-
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	writer := c.newMultipartForm(ctx, "sendSticker")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
 
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	contentType := writer.FormDataContentType()
 
-			writeErr <- pw.Close()
-		}()
+	if params.BusinessConnectionID != "" {
+		v := params.BusinessConnectionID
+		err = writer.WriteField("business_connection_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-		if params.BusinessConnectionID != "" {
-			v := params.BusinessConnectionID
-			err = writer.WriteField("business_connection_id", v)
-			if err != nil {
-				return
-			}
+	{
+		v := params.ChatID
+		err = writer.WriteField("chat_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := params.ChatID
-			err = writer.WriteField("chat_id", v)
-			if err != nil {
-				return
-			}
+	if params.MessageThreadID != 0 {
+		v := strconv.FormatInt(params.MessageThreadID, 10)
+		err = writer.WriteField("message_thread_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageThreadID != 0 {
-			v := strconv.FormatInt(params.MessageThreadID, 10)
-			err = writer.WriteField("message_thread_id", v)
-			if err != nil {
-				return
-			}
+	if params.DirectMessagesTopicID != 0 {
+		v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
+		err = writer.WriteField("direct_messages_topic_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DirectMessagesTopicID != 0 {
-			v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
-			err = writer.WriteField("direct_messages_topic_id", v)
-			if err != nil {
-				return
-			}
+	if params.ReceiverUserID != 0 {
+		v := strconv.FormatInt(params.ReceiverUserID, 10)
+		err = writer.WriteField("receiver_user_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReceiverUserID != 0 {
-			v := strconv.FormatInt(params.ReceiverUserID, 10)
-			err = writer.WriteField("receiver_user_id", v)
-			if err != nil {
-				return
-			}
+	if params.CallbackQueryID != "" {
+		v := params.CallbackQueryID
+		err = writer.WriteField("callback_query_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.CallbackQueryID != "" {
-			v := params.CallbackQueryID
-			err = writer.WriteField("callback_query_id", v)
-			if err != nil {
-				return
-			}
+	{
+		err = writeFormInputFile(
+			writer,
+			&params.Sticker,
+			"sticker",
+		)
+		if err != nil {
+			return
 		}
 
-		{
-			err = createFormFileFromInputFile(
-				writer,
-				&params.Sticker,
-				"sticker",
-			)
-			if err != nil {
-				return
-			}
+	}
+
+	if params.Emoji != "" {
+		v := params.Emoji
+		err = writer.WriteField("emoji", v)
+		if err != nil {
+			return
+		}
+	}
 
+	if params.DisableNotification {
+		v := strconv.FormatBool(params.DisableNotification)
+		err = writer.WriteField("disable_notification", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Emoji != "" {
-			v := params.Emoji
-			err = writer.WriteField("emoji", v)
-			if err != nil {
-				return
-			}
+	if params.ProtectContent {
+		v := strconv.FormatBool(params.ProtectContent)
+		err = writer.WriteField("protect_content", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DisableNotification {
-			v := strconv.FormatBool(params.DisableNotification)
-			err = writer.WriteField("disable_notification", v)
-			if err != nil {
-				return
-			}
+	if params.AllowPaidBroadcast {
+		v := strconv.FormatBool(params.AllowPaidBroadcast)
+		err = writer.WriteField("allow_paid_broadcast", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ProtectContent {
-			v := strconv.FormatBool(params.ProtectContent)
-			err = writer.WriteField("protect_content", v)
-			if err != nil {
-				return
-			}
+	if params.MessageEffectID != "" {
+		v := params.MessageEffectID
+		err = writer.WriteField("message_effect_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.AllowPaidBroadcast {
-			v := strconv.FormatBool(params.AllowPaidBroadcast)
-			err = writer.WriteField("allow_paid_broadcast", v)
-			if err != nil {
-				return
-			}
+	if params.SuggestedPostParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.SuggestedPostParameters)
+		if err != nil {
+			return
 		}
 
-		if params.MessageEffectID != "" {
-			v := params.MessageEffectID
-			err = writer.WriteField("message_effect_id", v)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("suggested_post_parameters")
+		if err != nil {
+			return
 		}
 
-		if params.SuggestedPostParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.SuggestedPostParameters)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("suggested_post_parameters")
-			if err != nil {
-				return
-			}
+	if params.ReplyParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyParameters)
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_parameters")
+		if err != nil {
+			return
 		}
 
-		if params.ReplyParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyParameters)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_parameters")
-			if err != nil {
-				return
-			}
+	if params.ReplyMarkup != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyMarkup)
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_markup")
+		if err != nil {
+			return
 		}
 
-		if params.ReplyMarkup != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyMarkup)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_markup")
-			if err != nil {
-				return
-			}
+	if err = writer.finish(); err != nil {
+		return
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
-		}
+	reader := writer.body()
 
-	}()
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendSticker", reader, contentType, nil)
+	result, err = c.call(ctx, "sendSticker", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -20273,19 +21512,31 @@ func WithSendVenueReplyMarkup(value *ReplyMarkup) SendVenueOption {
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendVenue(ctx context.Context, params *SendVenueParams) (ret *Message, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendVenue", reader, contentType, nil)
+	result, err = c.call(ctx, "sendVenue", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -20701,309 +21952,298 @@ func WithSendVideoReplyMarkup(value *ReplyMarkup) SendVideoOption {
 // [Document]: https://core.telegram.org/bots/api#document
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendVideo(ctx context.Context, params *SendVideoParams) (ret *Message, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
-	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
+	writer := c.newMultipartForm(ctx, "sendVideo")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
 
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
-
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	contentType := writer.FormDataContentType()
 
-			writeErr <- pw.Close()
-		}()
+	if params.BusinessConnectionID != "" {
+		v := params.BusinessConnectionID
+		err = writer.WriteField("business_connection_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-		if params.BusinessConnectionID != "" {
-			v := params.BusinessConnectionID
-			err = writer.WriteField("business_connection_id", v)
-			if err != nil {
-				return
-			}
+	{
+		v := params.ChatID
+		err = writer.WriteField("chat_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := params.ChatID
-			err = writer.WriteField("chat_id", v)
-			if err != nil {
-				return
-			}
+	if params.MessageThreadID != 0 {
+		v := strconv.FormatInt(params.MessageThreadID, 10)
+		err = writer.WriteField("message_thread_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageThreadID != 0 {
-			v := strconv.FormatInt(params.MessageThreadID, 10)
-			err = writer.WriteField("message_thread_id", v)
-			if err != nil {
-				return
-			}
+	if params.DirectMessagesTopicID != 0 {
+		v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
+		err = writer.WriteField("direct_messages_topic_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DirectMessagesTopicID != 0 {
-			v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
-			err = writer.WriteField("direct_messages_topic_id", v)
-			if err != nil {
-				return
-			}
+	if params.ReceiverUserID != 0 {
+		v := strconv.FormatInt(params.ReceiverUserID, 10)
+		err = writer.WriteField("receiver_user_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReceiverUserID != 0 {
-			v := strconv.FormatInt(params.ReceiverUserID, 10)
-			err = writer.WriteField("receiver_user_id", v)
-			if err != nil {
-				return
-			}
+	if params.CallbackQueryID != "" {
+		v := params.CallbackQueryID
+		err = writer.WriteField("callback_query_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.CallbackQueryID != "" {
-			v := params.CallbackQueryID
-			err = writer.WriteField("callback_query_id", v)
-			if err != nil {
-				return
-			}
+	{
+		err = writeFormInputFile(
+			writer,
+			&params.Video,
+			"video",
+		)
+		if err != nil {
+			return
 		}
 
-		{
-			err = createFormFileFromInputFile(
-				writer,
-				&params.Video,
-				"video",
-			)
-			if err != nil {
-				return
-			}
+	}
+
+	if params.Duration != 0 {
+		v := strconv.FormatInt(params.Duration, 10)
+		err = writer.WriteField("duration", v)
+		if err != nil {
+			return
+		}
+	}
 
+	if params.Width != 0 {
+		v := strconv.FormatInt(params.Width, 10)
+		err = writer.WriteField("width", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Duration != 0 {
-			v := strconv.FormatInt(params.Duration, 10)
-			err = writer.WriteField("duration", v)
-			if err != nil {
-				return
-			}
+	if params.Height != 0 {
+		v := strconv.FormatInt(params.Height, 10)
+		err = writer.WriteField("height", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Width != 0 {
-			v := strconv.FormatInt(params.Width, 10)
-			err = writer.WriteField("width", v)
+	{
+		if params.Thumbnail != nil {
+			err = writeFormInputFile(
+				writer,
+				params.Thumbnail,
+				"thumbnail",
+			)
 			if err != nil {
 				return
 			}
 		}
 
-		if params.Height != 0 {
-			v := strconv.FormatInt(params.Height, 10)
-			err = writer.WriteField("height", v)
+	}
+
+	{
+		if params.Cover != nil {
+			err = writeFormInputFile(
+				writer,
+				params.Cover,
+				"cover",
+			)
 			if err != nil {
 				return
 			}
 		}
 
-		{
-			if params.Thumbnail != nil {
-				err = createFormFileFromInputFile(
-					writer,
-					params.Thumbnail,
-					"thumbnail",
-				)
-				if err != nil {
-					return
-				}
-			}
+	}
 
+	if params.StartTimestamp != 0 {
+		v := strconv.FormatInt(params.StartTimestamp, 10)
+		err = writer.WriteField("start_timestamp", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			if params.Cover != nil {
-				err = createFormFileFromInputFile(
-					writer,
-					params.Cover,
-					"cover",
-				)
-				if err != nil {
-					return
-				}
-			}
-
+	if params.Caption != "" {
+		v := params.Caption
+		err = writer.WriteField("caption", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.StartTimestamp != 0 {
-			v := strconv.FormatInt(params.StartTimestamp, 10)
-			err = writer.WriteField("start_timestamp", v)
-			if err != nil {
-				return
-			}
+	if params.ParseMode != "" {
+		v := params.ParseMode
+		err = writer.WriteField("parse_mode", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Caption != "" {
-			v := params.Caption
-			err = writer.WriteField("caption", v)
-			if err != nil {
-				return
-			}
+	if len(params.CaptionEntities) != 0 {
+		var bs []byte
+		bs, err = json.Marshal(&params.CaptionEntities)
+		if err != nil {
+			return
 		}
 
-		if params.ParseMode != "" {
-			v := params.ParseMode
-			err = writer.WriteField("parse_mode", v)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("caption_entities")
+		if err != nil {
+			return
 		}
 
-		if len(params.CaptionEntities) != 0 {
-			var bs []byte
-			bs, err = json.Marshal(&params.CaptionEntities)
-			if err != nil {
-				return
-			}
-
-			var p io.Writer
-			p, err = writer.CreateFormField("caption_entities")
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+	if params.ShowCaptionAboveMedia {
+		v := strconv.FormatBool(params.ShowCaptionAboveMedia)
+		err = writer.WriteField("show_caption_above_media", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ShowCaptionAboveMedia {
-			v := strconv.FormatBool(params.ShowCaptionAboveMedia)
-			err = writer.WriteField("show_caption_above_media", v)
-			if err != nil {
-				return
-			}
+	if params.HasSpoiler {
+		v := strconv.FormatBool(params.HasSpoiler)
+		err = writer.WriteField("has_spoiler", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.HasSpoiler {
-			v := strconv.FormatBool(params.HasSpoiler)
-			err = writer.WriteField("has_spoiler", v)
-			if err != nil {
-				return
-			}
+	if params.SupportsStreaming {
+		v := strconv.FormatBool(params.SupportsStreaming)
+		err = writer.WriteField("supports_streaming", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.SupportsStreaming {
-			v := strconv.FormatBool(params.SupportsStreaming)
-			err = writer.WriteField("supports_streaming", v)
-			if err != nil {
-				return
-			}
+	if params.DisableNotification {
+		v := strconv.FormatBool(params.DisableNotification)
+		err = writer.WriteField("disable_notification", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DisableNotification {
-			v := strconv.FormatBool(params.DisableNotification)
-			err = writer.WriteField("disable_notification", v)
-			if err != nil {
-				return
-			}
+	if params.ProtectContent {
+		v := strconv.FormatBool(params.ProtectContent)
+		err = writer.WriteField("protect_content", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ProtectContent {
-			v := strconv.FormatBool(params.ProtectContent)
-			err = writer.WriteField("protect_content", v)
-			if err != nil {
-				return
-			}
+	if params.AllowPaidBroadcast {
+		v := strconv.FormatBool(params.AllowPaidBroadcast)
+		err = writer.WriteField("allow_paid_broadcast", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.AllowPaidBroadcast {
-			v := strconv.FormatBool(params.AllowPaidBroadcast)
-			err = writer.WriteField("allow_paid_broadcast", v)
-			if err != nil {
-				return
-			}
+	if params.MessageEffectID != "" {
+		v := params.MessageEffectID
+		err = writer.WriteField("message_effect_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageEffectID != "" {
-			v := params.MessageEffectID
-			err = writer.WriteField("message_effect_id", v)
-			if err != nil {
-				return
-			}
+	if params.SuggestedPostParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.SuggestedPostParameters)
+		if err != nil {
+			return
 		}
 
-		if params.SuggestedPostParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.SuggestedPostParameters)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("suggested_post_parameters")
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("suggested_post_parameters")
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+	if params.ReplyParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyParameters)
+		if err != nil {
+			return
 		}
 
-		if params.ReplyParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyParameters)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_parameters")
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_parameters")
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+	if params.ReplyMarkup != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyMarkup)
+		if err != nil {
+			return
 		}
 
-		if params.ReplyMarkup != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyMarkup)
-			if err != nil {
-				return
-			}
-
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_markup")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_markup")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
+
+	if err = writer.finish(); err != nil {
+		return
+	}
+
+	reader := writer.body()
 
-	}()
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendVideo", reader, contentType, nil)
+	result, err = c.call(ctx, "sendVideo", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -21301,220 +22541,209 @@ func WithSendVideoNoteReplyMarkup(value *ReplyMarkup) SendVideoNoteOption {
 // [v.4.0]: https://telegram.org/blog/video-messages-and-telescope
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendVideoNote(ctx context.Context, params *SendVideoNoteParams) (ret *Message, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
+	writer := c.newMultipartForm(ctx, "sendVideoNote")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
+
 	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
 
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	if params.BusinessConnectionID != "" {
+		v := params.BusinessConnectionID
+		err = writer.WriteField("business_connection_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	{
+		v := params.ChatID
+		err = writer.WriteField("chat_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-			writeErr <- pw.Close()
-		}()
+	if params.MessageThreadID != 0 {
+		v := strconv.FormatInt(params.MessageThreadID, 10)
+		err = writer.WriteField("message_thread_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-		if params.BusinessConnectionID != "" {
-			v := params.BusinessConnectionID
-			err = writer.WriteField("business_connection_id", v)
-			if err != nil {
-				return
-			}
+	if params.DirectMessagesTopicID != 0 {
+		v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
+		err = writer.WriteField("direct_messages_topic_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := params.ChatID
-			err = writer.WriteField("chat_id", v)
-			if err != nil {
-				return
-			}
+	if params.ReceiverUserID != 0 {
+		v := strconv.FormatInt(params.ReceiverUserID, 10)
+		err = writer.WriteField("receiver_user_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageThreadID != 0 {
-			v := strconv.FormatInt(params.MessageThreadID, 10)
-			err = writer.WriteField("message_thread_id", v)
-			if err != nil {
-				return
-			}
+	if params.CallbackQueryID != "" {
+		v := params.CallbackQueryID
+		err = writer.WriteField("callback_query_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DirectMessagesTopicID != 0 {
-			v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
-			err = writer.WriteField("direct_messages_topic_id", v)
-			if err != nil {
-				return
-			}
+	{
+		err = writeFormInputFile(
+			writer,
+			&params.VideoNote,
+			"video_note",
+		)
+		if err != nil {
+			return
 		}
 
-		if params.ReceiverUserID != 0 {
-			v := strconv.FormatInt(params.ReceiverUserID, 10)
-			err = writer.WriteField("receiver_user_id", v)
-			if err != nil {
-				return
-			}
+	}
+
+	if params.Duration != 0 {
+		v := strconv.FormatInt(params.Duration, 10)
+		err = writer.WriteField("duration", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.CallbackQueryID != "" {
-			v := params.CallbackQueryID
-			err = writer.WriteField("callback_query_id", v)
-			if err != nil {
-				return
-			}
+	if params.Length != 0 {
+		v := strconv.FormatInt(params.Length, 10)
+		err = writer.WriteField("length", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			err = createFormFileFromInputFile(
+	{
+		if params.Thumbnail != nil {
+			err = writeFormInputFile(
 				writer,
-				&params.VideoNote,
-				"video_note",
+				params.Thumbnail,
+				"thumbnail",
 			)
 			if err != nil {
 				return
 			}
-
 		}
 
-		if params.Duration != 0 {
-			v := strconv.FormatInt(params.Duration, 10)
-			err = writer.WriteField("duration", v)
-			if err != nil {
-				return
-			}
-		}
+	}
 
-		if params.Length != 0 {
-			v := strconv.FormatInt(params.Length, 10)
-			err = writer.WriteField("length", v)
-			if err != nil {
-				return
-			}
+	if params.DisableNotification {
+		v := strconv.FormatBool(params.DisableNotification)
+		err = writer.WriteField("disable_notification", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			if params.Thumbnail != nil {
-				err = createFormFileFromInputFile(
-					writer,
-					params.Thumbnail,
-					"thumbnail",
-				)
-				if err != nil {
-					return
-				}
-			}
-
+	if params.ProtectContent {
+		v := strconv.FormatBool(params.ProtectContent)
+		err = writer.WriteField("protect_content", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DisableNotification {
-			v := strconv.FormatBool(params.DisableNotification)
-			err = writer.WriteField("disable_notification", v)
-			if err != nil {
-				return
-			}
+	if params.AllowPaidBroadcast {
+		v := strconv.FormatBool(params.AllowPaidBroadcast)
+		err = writer.WriteField("allow_paid_broadcast", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ProtectContent {
-			v := strconv.FormatBool(params.ProtectContent)
-			err = writer.WriteField("protect_content", v)
-			if err != nil {
-				return
-			}
+	if params.MessageEffectID != "" {
+		v := params.MessageEffectID
+		err = writer.WriteField("message_effect_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.AllowPaidBroadcast {
-			v := strconv.FormatBool(params.AllowPaidBroadcast)
-			err = writer.WriteField("allow_paid_broadcast", v)
-			if err != nil {
-				return
-			}
+	if params.SuggestedPostParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.SuggestedPostParameters)
+		if err != nil {
+			return
 		}
 
-		if params.MessageEffectID != "" {
-			v := params.MessageEffectID
-			err = writer.WriteField("message_effect_id", v)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("suggested_post_parameters")
+		if err != nil {
+			return
 		}
 
-		if params.SuggestedPostParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.SuggestedPostParameters)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("suggested_post_parameters")
-			if err != nil {
-				return
-			}
+	if params.ReplyParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyParameters)
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_parameters")
+		if err != nil {
+			return
 		}
 
-		if params.ReplyParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyParameters)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_parameters")
-			if err != nil {
-				return
-			}
+	if params.ReplyMarkup != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyMarkup)
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_markup")
+		if err != nil {
+			return
 		}
 
-		if params.ReplyMarkup != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyMarkup)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_markup")
-			if err != nil {
-				return
-			}
+	if err = writer.finish(); err != nil {
+		return
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
-		}
+	reader := writer.body()
 
-	}()
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendVideoNote", reader, contentType, nil)
+	result, err = c.call(ctx, "sendVideoNote", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -21814,233 +23043,222 @@ func WithSendVoiceReplyMarkup(value *ReplyMarkup) SendVoiceOption {
 // [Document]: https://core.telegram.org/bots/api#document
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SendVoice(ctx context.Context, params *SendVoiceParams) (ret *Message, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
-	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
-
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	writer := c.newMultipartForm(ctx, "sendVoice")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
 
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	contentType := writer.FormDataContentType()
 
-			writeErr <- pw.Close()
-		}()
+	if params.BusinessConnectionID != "" {
+		v := params.BusinessConnectionID
+		err = writer.WriteField("business_connection_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-		if params.BusinessConnectionID != "" {
-			v := params.BusinessConnectionID
-			err = writer.WriteField("business_connection_id", v)
-			if err != nil {
-				return
-			}
+	{
+		v := params.ChatID
+		err = writer.WriteField("chat_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := params.ChatID
-			err = writer.WriteField("chat_id", v)
-			if err != nil {
-				return
-			}
+	if params.MessageThreadID != 0 {
+		v := strconv.FormatInt(params.MessageThreadID, 10)
+		err = writer.WriteField("message_thread_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MessageThreadID != 0 {
-			v := strconv.FormatInt(params.MessageThreadID, 10)
-			err = writer.WriteField("message_thread_id", v)
-			if err != nil {
-				return
-			}
+	if params.DirectMessagesTopicID != 0 {
+		v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
+		err = writer.WriteField("direct_messages_topic_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DirectMessagesTopicID != 0 {
-			v := strconv.FormatInt(params.DirectMessagesTopicID, 10)
-			err = writer.WriteField("direct_messages_topic_id", v)
-			if err != nil {
-				return
-			}
+	if params.ReceiverUserID != 0 {
+		v := strconv.FormatInt(params.ReceiverUserID, 10)
+		err = writer.WriteField("receiver_user_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ReceiverUserID != 0 {
-			v := strconv.FormatInt(params.ReceiverUserID, 10)
-			err = writer.WriteField("receiver_user_id", v)
-			if err != nil {
-				return
-			}
+	if params.CallbackQueryID != "" {
+		v := params.CallbackQueryID
+		err = writer.WriteField("callback_query_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.CallbackQueryID != "" {
-			v := params.CallbackQueryID
-			err = writer.WriteField("callback_query_id", v)
-			if err != nil {
-				return
-			}
+	{
+		err = writeFormInputFile(
+			writer,
+			&params.Voice,
+			"voice",
+		)
+		if err != nil {
+			return
 		}
 
-		{
-			err = createFormFileFromInputFile(
-				writer,
-				&params.Voice,
-				"voice",
-			)
-			if err != nil {
-				return
-			}
+	}
 
+	if params.Caption != "" {
+		v := params.Caption
+		err = writer.WriteField("caption", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Caption != "" {
-			v := params.Caption
-			err = writer.WriteField("caption", v)
-			if err != nil {
-				return
-			}
+	if params.ParseMode != "" {
+		v := params.ParseMode
+		err = writer.WriteField("parse_mode", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ParseMode != "" {
-			v := params.ParseMode
-			err = writer.WriteField("parse_mode", v)
-			if err != nil {
-				return
-			}
+	if len(params.CaptionEntities) != 0 {
+		var bs []byte
+		bs, err = json.Marshal(&params.CaptionEntities)
+		if err != nil {
+			return
 		}
 
-		if len(params.CaptionEntities) != 0 {
-			var bs []byte
-			bs, err = json.Marshal(&params.CaptionEntities)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("caption_entities")
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("caption_entities")
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+	if params.Duration != 0 {
+		v := strconv.FormatInt(params.Duration, 10)
+		err = writer.WriteField("duration", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.Duration != 0 {
-			v := strconv.FormatInt(params.Duration, 10)
-			err = writer.WriteField("duration", v)
-			if err != nil {
-				return
-			}
+	if params.DisableNotification {
+		v := strconv.FormatBool(params.DisableNotification)
+		err = writer.WriteField("disable_notification", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DisableNotification {
-			v := strconv.FormatBool(params.DisableNotification)
-			err = writer.WriteField("disable_notification", v)
-			if err != nil {
-				return
-			}
+	if params.ProtectContent {
+		v := strconv.FormatBool(params.ProtectContent)
+		err = writer.WriteField("protect_content", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.ProtectContent {
-			v := strconv.FormatBool(params.ProtectContent)
-			err = writer.WriteField("protect_content", v)
-			if err != nil {
-				return
-			}
+	if params.AllowPaidBroadcast {
+		v := strconv.FormatBool(params.AllowPaidBroadcast)
+		err = writer.WriteField("allow_paid_broadcast", v)
+		if err != nil {
+			return
+		}
+	}
+
+	if params.MessageEffectID != "" {
+		v := params.MessageEffectID
+		err = writer.WriteField("message_effect_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.AllowPaidBroadcast {
-			v := strconv.FormatBool(params.AllowPaidBroadcast)
-			err = writer.WriteField("allow_paid_broadcast", v)
-			if err != nil {
-				return
-			}
+	if params.SuggestedPostParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.SuggestedPostParameters)
+		if err != nil {
+			return
 		}
 
-		if params.MessageEffectID != "" {
-			v := params.MessageEffectID
-			err = writer.WriteField("message_effect_id", v)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("suggested_post_parameters")
+		if err != nil {
+			return
 		}
 
-		if params.SuggestedPostParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.SuggestedPostParameters)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("suggested_post_parameters")
-			if err != nil {
-				return
-			}
+	if params.ReplyParameters != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyParameters)
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_parameters")
+		if err != nil {
+			return
 		}
 
-		if params.ReplyParameters != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyParameters)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_parameters")
-			if err != nil {
-				return
-			}
+	if params.ReplyMarkup != nil {
+		var bs []byte
+		bs, err = json.Marshal(&params.ReplyMarkup)
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("reply_markup")
+		if err != nil {
+			return
 		}
 
-		if params.ReplyMarkup != nil {
-			var bs []byte
-			bs, err = json.Marshal(&params.ReplyMarkup)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
+		}
+	}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("reply_markup")
-			if err != nil {
-				return
-			}
+	if err = writer.finish(); err != nil {
+		return
+	}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
-		}
+	reader := writer.body()
 
-	}()
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "sendVoice", reader, contentType, nil)
+	result, err = c.call(ctx, "sendVoice", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -22099,19 +23317,31 @@ func WithSetBusinessAccountBioBio(value string) SetBusinessAccountBioOption {
 // Requires the can_change_bio business bot right.
 // Returns True on success.
 func (c *Client) SetBusinessAccountBio(ctx context.Context, params *SetBusinessAccountBioParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setBusinessAccountBio", reader, contentType, nil)
+	result, err = c.call(ctx, "setBusinessAccountBio", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -22181,19 +23411,31 @@ func WithSetBusinessAccountGiftSettingsAcceptedGiftTypes(value AcceptedGiftTypes
 // Requires the can_change_gift_settings business bot right.
 // Returns True on success.
 func (c *Client) SetBusinessAccountGiftSettings(ctx context.Context, params *SetBusinessAccountGiftSettingsParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setBusinessAccountGiftSettings", reader, contentType, nil)
+	result, err = c.call(ctx, "setBusinessAccountGiftSettings", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -22263,19 +23505,31 @@ func WithSetBusinessAccountNameLastName(value string) SetBusinessAccountNameOpti
 // Requires the can_change_name business bot right.
 // Returns True on success.
 func (c *Client) SetBusinessAccountName(ctx context.Context, params *SetBusinessAccountNameParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setBusinessAccountName", reader, contentType, nil)
+	result, err = c.call(ctx, "setBusinessAccountName", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -22347,97 +23601,86 @@ func WithSetBusinessAccountProfilePhotoIsPublic(value bool) SetBusinessAccountPr
 // Requires the can_edit_profile_photo business bot right.
 // Returns True on success.
 func (c *Client) SetBusinessAccountProfilePhoto(ctx context.Context, params *SetBusinessAccountProfilePhotoParams) (ret bool, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
+	writer := c.newMultipartForm(ctx, "setBusinessAccountProfilePhoto")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
+
 	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
 
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	{
+		v := params.BusinessConnectionID
+		err = writer.WriteField("business_connection_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-			err = writer.Close()
+	{
+		switch {
+		case params.Photo.InputProfilePhotoStatic != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Photo.InputProfilePhotoStatic.Photo,
+				"photo_static_photo",
+			)
 			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
 				return
 			}
-
-			writeErr <- pw.Close()
-		}()
-
-		{
-			v := params.BusinessConnectionID
-			err = writer.WriteField("business_connection_id", v)
+		case params.Photo.InputProfilePhotoAnimated != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Photo.InputProfilePhotoAnimated.Animation,
+				"photo_animated_animation",
+			)
 			if err != nil {
 				return
 			}
 		}
 
-		{
-			switch {
-			case params.Photo.InputProfilePhotoStatic != nil:
-				err = createFormFileFromInputFile(
-					writer,
-					&params.Photo.InputProfilePhotoStatic.Photo,
-					"photo_static_photo",
-				)
-				if err != nil {
-					return
-				}
-			case params.Photo.InputProfilePhotoAnimated != nil:
-				err = createFormFileFromInputFile(
-					writer,
-					&params.Photo.InputProfilePhotoAnimated.Animation,
-					"photo_animated_animation",
-				)
-				if err != nil {
-					return
-				}
-			}
-
-			var bs []byte
-			bs, err = json.Marshal(&params.Photo)
-			if err != nil {
-				return
-			}
+		var bs []byte
+		bs, err = json.Marshal(&params.Photo)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("photo")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("photo")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.IsPublic {
-			v := strconv.FormatBool(params.IsPublic)
-			err = writer.WriteField("is_public", v)
-			if err != nil {
-				return
-			}
+	if params.IsPublic {
+		v := strconv.FormatBool(params.IsPublic)
+		err = writer.WriteField("is_public", v)
+		if err != nil {
+			return
 		}
+	}
+
+	if err = writer.finish(); err != nil {
+		return
+	}
 
-	}()
+	reader := writer.body()
+
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setBusinessAccountProfilePhoto", reader, contentType, nil)
+	result, err = c.call(ctx, "setBusinessAccountProfilePhoto", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -22495,19 +23738,31 @@ func WithSetBusinessAccountUsernameUsername(value string) SetBusinessAccountUser
 // Requires the can_change_username business bot right.
 // Returns True on success.
 func (c *Client) SetBusinessAccountUsername(ctx context.Context, params *SetBusinessAccountUsernameParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setBusinessAccountUsername", reader, contentType, nil)
+	result, err = c.call(ctx, "setBusinessAccountUsername", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -22576,19 +23831,31 @@ func WithSetChatAdministratorCustomTitleCustomTitle(value string) SetChatAdminis
 // Use this method to set a custom title for an administrator in a supergroup promoted by the bot.
 // Returns True on success.
 func (c *Client) SetChatAdministratorCustomTitle(ctx context.Context, params *SetChatAdministratorCustomTitleParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setChatAdministratorCustomTitle", reader, contentType, nil)
+	result, err = c.call(ctx, "setChatAdministratorCustomTitle", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -22646,19 +23913,31 @@ func WithSetChatDescriptionDescription(value string) SetChatDescriptionOption {
 // The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
 // Returns True on success.
 func (c *Client) SetChatDescription(ctx context.Context, params *SetChatDescriptionParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setChatDescription", reader, contentType, nil)
+	result, err = c.call(ctx, "setChatDescription", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -22728,19 +24007,31 @@ func WithSetChatMemberTagTag(value string) SetChatMemberTagOption {
 // The bot must be an administrator in the chat for this to work and must have the can_manage_tags administrator right.
 // Returns True on success.
 func (c *Client) SetChatMemberTag(ctx context.Context, params *SetChatMemberTagParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setChatMemberTag", reader, contentType, nil)
+	result, err = c.call(ctx, "setChatMemberTag", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -22805,19 +24096,31 @@ func WithSetChatMenuButtonMenuButton(value *MenuButton) SetChatMenuButtonOption
 // Use this method to change the bot's menu button in a private chat, or the default menu button.
 // Returns True on success.
 func (c *Client) SetChatMenuButton(ctx context.Context, params *SetChatMenuButtonParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setChatMenuButton", reader, contentType, nil)
+	result, err = c.call(ctx, "setChatMenuButton", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -22889,19 +24192,31 @@ func WithSetChatPermissionsUseIndependentChatPermissions(value bool) SetChatPerm
 // The bot must be an administrator in the group or a supergroup for this to work and must have the can_restrict_members administrator rights.
 // Returns True on success.
 func (c *Client) SetChatPermissions(ctx context.Context, params *SetChatPermissionsParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setChatPermissions", reader, contentType, nil)
+	result, err = c.call(ctx, "setChatPermissions", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -22960,61 +24275,50 @@ func WithSetChatPhotoPhoto(value InputFile) SetChatPhotoOption {
 // The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
 // Returns True on success.
 func (c *Client) SetChatPhoto(ctx context.Context, params *SetChatPhotoParams) (ret bool, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
-	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
-
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	writer := c.newMultipartForm(ctx, "setChatPhoto")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
 
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	contentType := writer.FormDataContentType()
 
-			writeErr <- pw.Close()
-		}()
+	{
+		v := params.ChatID
+		err = writer.WriteField("chat_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-		{
-			v := params.ChatID
-			err = writer.WriteField("chat_id", v)
-			if err != nil {
-				return
-			}
+	{
+		err = writeFormInputFile(
+			writer,
+			&params.Photo,
+			"photo",
+		)
+		if err != nil {
+			return
 		}
 
-		{
-			err = createFormFileFromInputFile(
-				writer,
-				&params.Photo,
-				"photo",
-			)
-			if err != nil {
-				return
-			}
+	}
 
-		}
+	if err = writer.finish(); err != nil {
+		return
+	}
+
+	reader := writer.body()
 
-	}()
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setChatPhoto", reader, contentType, nil)
+	result, err = c.call(ctx, "setChatPhoto", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -23075,19 +24379,31 @@ func WithSetChatStickerSetStickerSetName(value string) SetChatStickerSetOption {
 //
 // [getChat]: https://core.telegram.org/bots/api#getchat
 func (c *Client) SetChatStickerSet(ctx context.Context, params *SetChatStickerSetParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setChatStickerSet", reader, contentType, nil)
+	result, err = c.call(ctx, "setChatStickerSet", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -23146,19 +24462,31 @@ func WithSetChatTitleTitle(value string) SetChatTitleOption {
 // The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
 // Returns True on success.
 func (c *Client) SetChatTitle(ctx context.Context, params *SetChatTitleParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setChatTitle", reader, contentType, nil)
+	result, err = c.call(ctx, "setChatTitle", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -23215,19 +24543,31 @@ func WithSetCustomEmojiStickerSetThumbnailCustomEmojiID(value string) SetCustomE
 // Use this method to set the thumbnail of a custom emoji sticker set.
 // Returns True on success.
 func (c *Client) SetCustomEmojiStickerSetThumbnail(ctx context.Context, params *SetCustomEmojiStickerSetThumbnailParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setCustomEmojiStickerSetThumbnail", reader, contentType, nil)
+	result, err = c.call(ctx, "setCustomEmojiStickerSetThumbnail", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -23355,19 +24695,31 @@ func WithSetGameScoreInlineMessageID(value string) SetGameScoreOption {
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) SetGameScore(ctx context.Context, params *SetGameScoreParams) (ret *Message, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setGameScore", reader, contentType, nil)
+	result, err = c.call(ctx, "setGameScore", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -23441,19 +24793,31 @@ func WithSetManagedBotAccessSettingsAddedUserIDs(value []int64) SetManagedBotAcc
 // Use this method to change the access settings of a managed bot.
 // Returns True on success.
 func (c *Client) SetManagedBotAccessSettings(ctx context.Context, params *SetManagedBotAccessSettingsParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setManagedBotAccessSettings", reader, contentType, nil)
+	result, err = c.call(ctx, "setManagedBotAccessSettings", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -23545,19 +24909,31 @@ func WithSetMessageReactionIsBig(value bool) SetMessageReactionOption {
 // Bots can't use paid reactions.
 // Returns True on success.
 func (c *Client) SetMessageReaction(ctx context.Context, params *SetMessageReactionParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setMessageReaction", reader, contentType, nil)
+	result, err = c.call(ctx, "setMessageReaction", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -23639,19 +25015,31 @@ func WithSetMyCommandsLanguageCode(value string) SetMyCommandsOption {
 //
 // [this manual]: https://core.telegram.org/bots/features#commands
 func (c *Client) SetMyCommands(ctx context.Context, params *SetMyCommandsParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setMyCommands", reader, contentType, nil)
+	result, err = c.call(ctx, "setMyCommands", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -23713,19 +25101,31 @@ func WithSetMyDefaultAdministratorRightsForChannels(value bool) SetMyDefaultAdmi
 // These rights will be suggested to users, but they are free to modify the list before adding the bot.
 // Returns True on success.
 func (c *Client) SetMyDefaultAdministratorRights(ctx context.Context, params *SetMyDefaultAdministratorRightsParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setMyDefaultAdministratorRights", reader, contentType, nil)
+	result, err = c.call(ctx, "setMyDefaultAdministratorRights", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -23786,19 +25186,31 @@ func WithSetMyDescriptionLanguageCode(value string) SetMyDescriptionOption {
 // Use this method to change the bot's description, which is shown in the chat with the bot if the chat is empty.
 // Returns True on success.
 func (c *Client) SetMyDescription(ctx context.Context, params *SetMyDescriptionParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setMyDescription", reader, contentType, nil)
+	result, err = c.call(ctx, "setMyDescription", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -23859,19 +25271,31 @@ func WithSetMyNameLanguageCode(value string) SetMyNameOption {
 // Use this method to change the bot's name.
 // Returns True on success.
 func (c *Client) SetMyName(ctx context.Context, params *SetMyNameParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setMyName", reader, contentType, nil)
+	result, err = c.call(ctx, "setMyName", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -23916,81 +25340,70 @@ func WithSetMyProfilePhotoPhoto(value InputProfilePhoto) SetMyProfilePhotoOption
 // Changes the profile photo of the bot.
 // Returns True on success.
 func (c *Client) SetMyProfilePhoto(ctx context.Context, params *SetMyProfilePhotoParams) (ret bool, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
+	writer := c.newMultipartForm(ctx, "setMyProfilePhoto")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
+
 	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
 
-	go func() {
-		var err error
-		defer func() {
+	{
+		switch {
+		case params.Photo.InputProfilePhotoStatic != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Photo.InputProfilePhotoStatic.Photo,
+				"photo_static_photo",
+			)
 			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
 				return
 			}
-
-			err = writer.Close()
+		case params.Photo.InputProfilePhotoAnimated != nil:
+			err = createFormFileFromInputFile(
+				writer,
+				&params.Photo.InputProfilePhotoAnimated.Animation,
+				"photo_animated_animation",
+			)
 			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
 				return
 			}
+		}
 
-			writeErr <- pw.Close()
-		}()
-
-		{
-			switch {
-			case params.Photo.InputProfilePhotoStatic != nil:
-				err = createFormFileFromInputFile(
-					writer,
-					&params.Photo.InputProfilePhotoStatic.Photo,
-					"photo_static_photo",
-				)
-				if err != nil {
-					return
-				}
-			case params.Photo.InputProfilePhotoAnimated != nil:
-				err = createFormFileFromInputFile(
-					writer,
-					&params.Photo.InputProfilePhotoAnimated.Animation,
-					"photo_animated_animation",
-				)
-				if err != nil {
-					return
-				}
-			}
-
-			var bs []byte
-			bs, err = json.Marshal(&params.Photo)
-			if err != nil {
-				return
-			}
+		var bs []byte
+		bs, err = json.Marshal(&params.Photo)
+		if err != nil {
+			return
+		}
 
-			var p io.Writer
-			p, err = writer.CreateFormField("photo")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("photo")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
+
+	if err = writer.finish(); err != nil {
+		return
+	}
+
+	reader := writer.body()
 
-	}()
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setMyProfilePhoto", reader, contentType, nil)
+	result, err = c.call(ctx, "setMyProfilePhoto", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -24051,19 +25464,31 @@ func WithSetMyShortDescriptionLanguageCode(value string) SetMyShortDescriptionOp
 // Use this method to change the bot's short description, which is shown on the bot's profile page and is sent together with the link when users share the bot.
 // Returns True on success.
 func (c *Client) SetMyShortDescription(ctx context.Context, params *SetMyShortDescriptionParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setMyShortDescription", reader, contentType, nil)
+	result, err = c.call(ctx, "setMyShortDescription", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -24124,19 +25549,31 @@ func WithSetPassportDataErrorsErrors(value []PassportElementError) SetPassportDa
 // For example, if a birthday date seems invalid, a submitted document is blurry, a scan shows evidence of tampering, etc.
 // Supply some details in the error message to make sure the user knows how to correct the issues.
 func (c *Client) SetPassportDataErrors(ctx context.Context, params *SetPassportDataErrorsParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setPassportDataErrors", reader, contentType, nil)
+	result, err = c.call(ctx, "setPassportDataErrors", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -24194,19 +25631,31 @@ func WithSetStickerEmojiListEmojiList(value []string) SetStickerEmojiListOption
 // The sticker must belong to a sticker set created by the bot.
 // Returns True on success.
 func (c *Client) SetStickerEmojiList(ctx context.Context, params *SetStickerEmojiListParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setStickerEmojiList", reader, contentType, nil)
+	result, err = c.call(ctx, "setStickerEmojiList", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -24264,19 +25713,31 @@ func WithSetStickerKeywordsKeywords(value []string) SetStickerKeywordsOption {
 // The sticker must belong to a sticker set created by the bot.
 // Returns True on success.
 func (c *Client) SetStickerKeywords(ctx context.Context, params *SetStickerKeywordsParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setStickerKeywords", reader, contentType, nil)
+	result, err = c.call(ctx, "setStickerKeywords", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -24338,19 +25799,31 @@ func WithSetStickerMaskPositionMaskPosition(value *MaskPosition) SetStickerMaskP
 //
 // [mask position]: https://core.telegram.org/bots/api#maskposition
 func (c *Client) SetStickerMaskPosition(ctx context.Context, params *SetStickerMaskPositionParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setStickerMaskPosition", reader, contentType, nil)
+	result, err = c.call(ctx, "setStickerMaskPosition", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -24407,19 +25880,31 @@ func WithSetStickerPositionInSetPosition(value int64) SetStickerPositionInSetOpt
 // Use this method to move a sticker in a set created by the bot to a specific position.
 // Returns True on success.
 func (c *Client) SetStickerPositionInSet(ctx context.Context, params *SetStickerPositionInSetParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setStickerPositionInSet", reader, contentType, nil)
+	result, err = c.call(ctx, "setStickerPositionInSet", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -24517,79 +26002,68 @@ func WithSetStickerSetThumbnailFormat(value string) SetStickerSetThumbnailOption
 // The format of the thumbnail file must match the format of the stickers in the set.
 // Returns True on success.
 func (c *Client) SetStickerSetThumbnail(ctx context.Context, params *SetStickerSetThumbnailParams) (ret bool, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
-	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
-
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	writer := c.newMultipartForm(ctx, "setStickerSetThumbnail")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
 
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	contentType := writer.FormDataContentType()
 
-			writeErr <- pw.Close()
-		}()
+	{
+		v := params.Name
+		err = writer.WriteField("name", v)
+		if err != nil {
+			return
+		}
+	}
 
-		{
-			v := params.Name
-			err = writer.WriteField("name", v)
-			if err != nil {
-				return
-			}
+	{
+		v := strconv.FormatInt(params.UserID, 10)
+		err = writer.WriteField("user_id", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := strconv.FormatInt(params.UserID, 10)
-			err = writer.WriteField("user_id", v)
+	{
+		if params.Thumbnail != nil {
+			err = writeFormInputFile(
+				writer,
+				params.Thumbnail,
+				"thumbnail",
+			)
 			if err != nil {
 				return
 			}
 		}
 
-		{
-			if params.Thumbnail != nil {
-				err = createFormFileFromInputFile(
-					writer,
-					params.Thumbnail,
-					"thumbnail",
-				)
-				if err != nil {
-					return
-				}
-			}
+	}
 
+	{
+		v := params.Format
+		err = writer.WriteField("format", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := params.Format
-			err = writer.WriteField("format", v)
-			if err != nil {
-				return
-			}
-		}
+	if err = writer.finish(); err != nil {
+		return
+	}
+
+	reader := writer.body()
 
-	}()
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setStickerSetThumbnail", reader, contentType, nil)
+	result, err = c.call(ctx, "setStickerSetThumbnail", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -24646,19 +26120,31 @@ func WithSetStickerSetTitleTitle(value string) SetStickerSetTitleOption {
 // Use this method to set the title of a created sticker set.
 // Returns True on success.
 func (c *Client) SetStickerSetTitle(ctx context.Context, params *SetStickerSetTitleParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setStickerSetTitle", reader, contentType, nil)
+	result, err = c.call(ctx, "setStickerSetTitle", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -24731,19 +26217,31 @@ func WithSetUserEmojiStatusEmojiStatusExpirationDate(value int64) SetUserEmojiSt
 //
 // [requestEmojiStatusAccess]: https://core.telegram.org/bots/webapps#initializing-mini-apps
 func (c *Client) SetUserEmojiStatus(ctx context.Context, params *SetUserEmojiStatusParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setUserEmojiStatus", reader, contentType, nil)
+	result, err = c.call(ctx, "setUserEmojiStatus", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -24897,114 +26395,103 @@ func WithSetWebhookSecretToken(value string) SetWebhookOption {
 // [Update]: https://core.telegram.org/bots/api#update
 // [HTTP status code]: https://en.wikipedia.org/wiki/List_of_HTTP_status_codes
 func (c *Client) SetWebhook(ctx context.Context, params *SetWebhookParams) (ret bool, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
-	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
-
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	writer := c.newMultipartForm(ctx, "setWebhook")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
 
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	contentType := writer.FormDataContentType()
 
-			writeErr <- pw.Close()
-		}()
+	{
+		v := params.URL
+		err = writer.WriteField("url", v)
+		if err != nil {
+			return
+		}
+	}
 
-		{
-			v := params.URL
-			err = writer.WriteField("url", v)
+	{
+		if params.Certificate != nil {
+			err = writeFormInputFile(
+				writer,
+				params.Certificate,
+				"certificate",
+			)
 			if err != nil {
 				return
 			}
 		}
 
-		{
-			if params.Certificate != nil {
-				err = createFormFileFromInputFile(
-					writer,
-					params.Certificate,
-					"certificate",
-				)
-				if err != nil {
-					return
-				}
-			}
+	}
 
+	if params.IpAddress != "" {
+		v := params.IpAddress
+		err = writer.WriteField("ip_address", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.IpAddress != "" {
-			v := params.IpAddress
-			err = writer.WriteField("ip_address", v)
-			if err != nil {
-				return
-			}
+	if params.MaxConnections != 0 {
+		v := strconv.FormatInt(params.MaxConnections, 10)
+		err = writer.WriteField("max_connections", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.MaxConnections != 0 {
-			v := strconv.FormatInt(params.MaxConnections, 10)
-			err = writer.WriteField("max_connections", v)
-			if err != nil {
-				return
-			}
+	if len(params.AllowedUpdates) != 0 {
+		var bs []byte
+		bs, err = json.Marshal(&params.AllowedUpdates)
+		if err != nil {
+			return
 		}
 
-		if len(params.AllowedUpdates) != 0 {
-			var bs []byte
-			bs, err = json.Marshal(&params.AllowedUpdates)
-			if err != nil {
-				return
-			}
-
-			var p io.Writer
-			p, err = writer.CreateFormField("allowed_updates")
-			if err != nil {
-				return
-			}
+		var p io.Writer
+		p, err = writer.CreateFormField("allowed_updates")
+		if err != nil {
+			return
+		}
 
-			_, err = p.Write(bs)
-			if err != nil {
-				return
-			}
+		_, err = p.Write(bs)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.DropPendingUpdates {
-			v := strconv.FormatBool(params.DropPendingUpdates)
-			err = writer.WriteField("drop_pending_updates", v)
-			if err != nil {
-				return
-			}
+	if params.DropPendingUpdates {
+		v := strconv.FormatBool(params.DropPendingUpdates)
+		err = writer.WriteField("drop_pending_updates", v)
+		if err != nil {
+			return
 		}
+	}
 
-		if params.SecretToken != "" {
-			v := params.SecretToken
-			err = writer.WriteField("secret_token", v)
-			if err != nil {
-				return
-			}
+	if params.SecretToken != "" {
+		v := params.SecretToken
+		err = writer.WriteField("secret_token", v)
+		if err != nil {
+			return
 		}
+	}
+
+	if err = writer.finish(); err != nil {
+		return
+	}
 
-	}()
+	reader := writer.body()
+
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "setWebhook", reader, contentType, nil)
+	result, err = c.call(ctx, "setWebhook", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -25109,19 +26596,31 @@ func WithStopMessageLiveLocationReplyMarkup(value *InlineKeyboardMarkup) StopMes
 //
 // [Message]: https://core.telegram.org/bots/api#message
 func (c *Client) StopMessageLiveLocation(ctx context.Context, params *StopMessageLiveLocationParams) (ret *Message, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "stopMessageLiveLocation", reader, contentType, nil)
+	result, err = c.call(ctx, "stopMessageLiveLocation", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -25209,19 +26708,31 @@ func WithStopPollReplyMarkup(value *InlineKeyboardMarkup) StopPollOption {
 //
 // [Poll]: https://core.telegram.org/bots/api#poll
 func (c *Client) StopPoll(ctx context.Context, params *StopPollParams) (ret *Poll, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "stopPoll", reader, contentType, nil)
+	result, err = c.call(ctx, "stopPoll", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -25280,19 +26791,31 @@ func WithTransferBusinessAccountStarsStarCount(value int64) TransferBusinessAcco
 // Requires the can_transfer_stars business bot right.
 // Returns True on success.
 func (c *Client) TransferBusinessAccountStars(ctx context.Context, params *TransferBusinessAccountStarsParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "transferBusinessAccountStars", reader, contentType, nil)
+	result, err = c.call(ctx, "transferBusinessAccountStars", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -25379,19 +26902,31 @@ func WithTransferGiftStarCount(value int64) TransferGiftOption {
 // Requires can_transfer_stars business bot right if the transfer is paid.
 // Returns True on success.
 func (c *Client) TransferGift(ctx context.Context, params *TransferGiftParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "transferGift", reader, contentType, nil)
+	result, err = c.call(ctx, "transferGift", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -25465,19 +27000,31 @@ func WithUnbanChatMemberOnlyIfBanned(value bool) UnbanChatMemberOption {
 // If you don't want this, use the parameter only_if_banned.
 // Returns True on success.
 func (c *Client) UnbanChatMember(ctx context.Context, params *UnbanChatMemberParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "unbanChatMember", reader, contentType, nil)
+	result, err = c.call(ctx, "unbanChatMember", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -25535,19 +27082,31 @@ func WithUnbanChatSenderChatSenderChatID(value int64) UnbanChatSenderChatOption
 // The bot must be an administrator for this to work and must have the appropriate administrator rights.
 // Returns True on success.
 func (c *Client) UnbanChatSenderChat(ctx context.Context, params *UnbanChatSenderChatParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "unbanChatSenderChat", reader, contentType, nil)
+	result, err = c.call(ctx, "unbanChatSenderChat", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -25593,19 +27152,31 @@ func WithUnhideGeneralForumTopicChatID(value string) UnhideGeneralForumTopicOpti
 // The bot must be an administrator in the chat for this to work and must have the can_manage_topics administrator rights.
 // Returns True on success.
 func (c *Client) UnhideGeneralForumTopic(ctx context.Context, params *UnhideGeneralForumTopicParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "unhideGeneralForumTopic", reader, contentType, nil)
+	result, err = c.call(ctx, "unhideGeneralForumTopic", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -25652,19 +27223,31 @@ func WithUnpinAllChatMessagesChatID(value string) UnpinAllChatMessagesOption {
 // Conversely, the bot must be an administrator with the 'can_pin_messages' right or the 'can_edit_messages' right to unpin all pinned messages in groups and channels respectively.
 // Returns True on success.
 func (c *Client) UnpinAllChatMessages(ctx context.Context, params *UnpinAllChatMessagesParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "unpinAllChatMessages", reader, contentType, nil)
+	result, err = c.call(ctx, "unpinAllChatMessages", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -25722,19 +27305,31 @@ func WithUnpinAllForumTopicMessagesMessageThreadID(value int64) UnpinAllForumTop
 // In the case of a supergroup chat the bot must be an administrator in the chat for this to work and must have the can_pin_messages administrator right in the supergroup.
 // Returns True on success.
 func (c *Client) UnpinAllForumTopicMessages(ctx context.Context, params *UnpinAllForumTopicMessagesParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "unpinAllForumTopicMessages", reader, contentType, nil)
+	result, err = c.call(ctx, "unpinAllForumTopicMessages", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -25780,19 +27375,31 @@ func WithUnpinAllGeneralForumTopicMessagesChatID(value string) UnpinAllGeneralFo
 // The bot must be an administrator in the chat for this to work and must have the can_pin_messages administrator right in the supergroup.
 // Returns True on success.
 func (c *Client) UnpinAllGeneralForumTopicMessages(ctx context.Context, params *UnpinAllGeneralForumTopicMessagesParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "unpinAllGeneralForumTopicMessages", reader, contentType, nil)
+	result, err = c.call(ctx, "unpinAllGeneralForumTopicMessages", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -25867,19 +27474,31 @@ func WithUnpinChatMessageMessageID(value int64) UnpinChatMessageOption {
 // Conversely, the bot must be an administrator with the 'can_pin_messages' right or the 'can_edit_messages' right to unpin messages in groups and channels respectively.
 // Returns True on success.
 func (c *Client) UnpinChatMessage(ctx context.Context, params *UnpinChatMessageParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "unpinChatMessage", reader, contentType, nil)
+	result, err = c.call(ctx, "unpinChatMessage", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -25964,19 +27583,31 @@ func WithUpgradeGiftStarCount(value int64) UpgradeGiftOption {
 // Additionally requires the can_transfer_stars business bot right if the upgrade is paid.
 // Returns True on success.
 func (c *Client) UpgradeGift(ctx context.Context, params *UpgradeGiftParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "upgradeGift", reader, contentType, nil)
+	result, err = c.call(ctx, "upgradeGift", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -26060,69 +27691,58 @@ func WithUploadStickerFileStickerFormat(value string) UploadStickerFileOption {
 // [replaceStickerInSet]: https://core.telegram.org/bots/api#replacestickerinset
 // [File]: https://core.telegram.org/bots/api#file
 func (c *Client) UploadStickerFile(ctx context.Context, params *UploadStickerFileParams) (ret *File, err error) {
-	reader, pw := io.Pipe()
-	writer := multipart.NewWriter(pw)
-	contentType := writer.FormDataContentType()
-	writeErr := make(chan error, 1)
-
-	go func() {
-		var err error
-		defer func() {
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	writer := c.newMultipartForm(ctx, "uploadStickerFile")
+	// The form is released once the Transport is done with the body too.
+	defer writer.Close()
 
-			err = writer.Close()
-			if err != nil {
-				_ = pw.CloseWithError(err)
-				writeErr <- err
-				return
-			}
+	contentType := writer.FormDataContentType()
 
-			writeErr <- pw.Close()
-		}()
+	{
+		v := strconv.FormatInt(params.UserID, 10)
+		err = writer.WriteField("user_id", v)
+		if err != nil {
+			return
+		}
+	}
 
-		{
-			v := strconv.FormatInt(params.UserID, 10)
-			err = writer.WriteField("user_id", v)
-			if err != nil {
-				return
-			}
+	{
+		err = writeFormInputFile(
+			writer,
+			&params.Sticker,
+			"sticker",
+		)
+		if err != nil {
+			return
 		}
 
-		{
-			err = createFormFileFromInputFile(
-				writer,
-				&params.Sticker,
-				"sticker",
-			)
-			if err != nil {
-				return
-			}
+	}
 
+	{
+		v := params.StickerFormat
+		err = writer.WriteField("sticker_format", v)
+		if err != nil {
+			return
 		}
+	}
 
-		{
-			v := params.StickerFormat
-			err = writer.WriteField("sticker_format", v)
-			if err != nil {
-				return
-			}
-		}
+	if err = writer.finish(); err != nil {
+		return
+	}
 
-	}()
+	reader := writer.body()
+
+	response := acquireBuffer()
+	defer releaseBuffer(response)
 
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "uploadStickerFile", reader, contentType, nil)
+	result, err = c.call(ctx, "uploadStickerFile", params, reader, contentType, response)
 	if err != nil {
-		_ = reader.CloseWithError(err)
-		<-writeErr
-		return
-	}
-	if err = <-writeErr; err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -26186,19 +27806,31 @@ func WithVerifyChatCustomDescription(value string) VerifyChatOption {
 //
 // [on behalf of the organization]: https://telegram.org/verify#third-party-verification
 func (c *Client) VerifyChat(ctx context.Context, params *VerifyChatParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "verifyChat", reader, contentType, nil)
+	result, err = c.call(ctx, "verifyChat", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -26259,19 +27891,31 @@ func WithVerifyUserCustomDescription(value string) VerifyUserOption {
 //
 // [on behalf of the organization]: https://telegram.org/verify#third-party-verification
 func (c *Client) VerifyUser(ctx context.Context, params *VerifyUserParams) (ret bool, err error) {
-	buffer := new(bytes.Buffer)
-	if err = json.NewEncoder(buffer).Encode(params); err != nil {
+	buffer := acquireBuffer()
+
+	if buffer.B, err = params.appendJSON(buffer.B); err != nil {
+		releaseBuffer(buffer)
 		return
 	}
 
-	reader := bytes.NewReader(buffer.Bytes())
+	// The buffer is released once the Transport is done with the body too.
+	reader := newBufferBody(buffer)
+	defer reader.Close()
 
 	contentType := "application/json"
 
+	response := acquireBuffer()
+	defer releaseBuffer(response)
+
 	var result json.RawMessage
 
-	result, err = c.Raw(ctx, "verifyUser", reader, contentType, nil)
+	result, err = c.call(ctx, "verifyUser", params, reader, contentType, response)
 	if err != nil {
+		if errors.Is(err, errWebhookReplied) {
+			// The call was delivered in the webhook response, its result is unknown.
+			err = nil
+		}
+
 		return
 	}
 
@@ -26285,32 +27929,29 @@ func (c *Client) VerifyUser(ctx context.Context, params *VerifyUserParams) (ret
 	return ret, err
 }
 
-func createFormFileFromInputFile(writer *multipart.Writer, f *InputFile, name string) error {
-	if f.File == nil {
-		return nil
-	}
-
-	var w io.Writer
-	var err error
-
-	f.fieldName = name
+func writeFormInputFile(writer *multipartForm, f *InputFile, name string) error {
+	if !f.isUpload() {
+		text, err := f.MarshalText()
+		if err != nil || len(text) == 0 {
+			return err
+		}
 
-	w, err = writer.CreateFormFile(f.fieldName, f.FileName)
-	if err != nil {
-		return err
+		return writer.WriteField(name, string(text))
 	}
 
-	_, err = io.Copy(w, f.File)
-	if err != nil {
-		return err
+	if fileID := writer.cachedFileID(f, name); fileID != "" {
+		return writer.WriteField(name, fileID)
 	}
 
-	if closer, ok := f.File.(io.Closer); ok {
-		err = closer.Close()
-		if err != nil {
-			return err
-		}
+	return createFormFileFromInputFile(writer, f, name)
+}
+
+func createFormFileFromInputFile(writer *multipartForm, f *InputFile, name string) error {
+	if !f.isUpload() {
+		return nil
 	}
 
-	return nil
+	f.fieldName = name
+
+	return writer.addFile(f)
 }
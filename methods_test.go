@@ -113,9 +113,13 @@ func TestSendRichMessage_JSONBody(t *testing.T) {
 	}
 
 	ctx := gogram.NewTestContext(t.Context(), client, nil)
-	if err := ctx.SendRichMessage(gogram.InputRichMessage{Html: "<b>hello</b>"}); err != nil {
+	msg, err := ctx.SendRichMessage(gogram.InputRichMessage{Html: "<b>hello</b>"})
+	if err != nil {
 		t.Fatalf("SendRichMessage: %v", err)
 	}
+	if msg.Text != "rich" {
+		t.Errorf("SendRichMessage returned text %q, want %q", msg.Text, "rich")
+	}
 
 	var body map[string]any
 	if err := json.Unmarshal(capturedBody, &body); err != nil {
@@ -0,0 +1,282 @@
+package gogram
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// defaultMetricsBuckets are the histogram upper bounds in seconds.
+var defaultMetricsBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// Metrics collects client metrics and serves them in the Prometheus text
+// exposition format. It is enabled with [WithMetrics] and can be mounted
+// next to the webhook with [WithWebhookHandler]:
+//
+//	metrics := gogram.NewMetrics()
+//	client, _ := gogram.NewClient(token,
+//		gogram.WithMetrics(metrics),
+//		gogram.WithWebhookHandler("GET /metrics", metrics),
+//	)
+//
+// Collected series:
+//   - gogram_api_requests_total{method,code}: Bot API calls by error code, 200 on success and 0 on network errors;
+//   - gogram_api_request_duration_seconds{method}: Bot API call latency, retries included;
+//   - gogram_api_retry_wait_seconds{method}: waits before retrying rate limited calls;
+//   - gogram_updates_total{kind}: processed updates;
+//   - gogram_update_lag_seconds{kind}: time from update date to processing;
+//   - gogram_handler_duration_seconds{kind}: update processing time;
+//   - gogram_handler_errors_total{kind}: updates whose handler failed or panicked.
+type Metrics struct {
+	apiRequests    counterVec
+	apiDuration    histogramVec
+	apiRetryWait   histogramVec
+	updates        counterVec
+	updateLag      histogramVec
+	handlerTime    histogramVec
+	handlerErrors  counterVec
+	metricFamilies []metricFamily
+}
+
+// NewMetrics creates an empty Metrics.
+func NewMetrics() *Metrics {
+	m := new(Metrics)
+
+	m.metricFamilies = []metricFamily{
+		{"gogram_api_requests_total", "Bot API calls by method and error code.", &m.apiRequests},
+		{"gogram_api_request_duration_seconds", "Bot API call latency in seconds.", &m.apiDuration},
+		{"gogram_api_retry_wait_seconds", "Waits in seconds before retrying rate limited Bot API calls.", &m.apiRetryWait},
+		{"gogram_updates_total", "Processed updates by kind.", &m.updates},
+		{"gogram_update_lag_seconds", "Time in seconds from update date to processing.", &m.updateLag},
+		{"gogram_handler_duration_seconds", "Update processing time in seconds.", &m.handlerTime},
+		{"gogram_handler_errors_total", "Updates whose handler returned an error or panicked.", &m.handlerErrors},
+	}
+
+	return m
+}
+
+// WithMetrics records client metrics into m, replacing the Metrics of an
+// earlier WithMetrics. API calls are measured by an interceptor running after
+// the ones set with [WithInterceptors].
+func WithMetrics(m *Metrics) ClientOption {
+	return func(c *Client) {
+		c.cfg.metrics = m
+	}
+}
+
+func (m *Metrics) interceptor(next Invoker) Invoker {
+	return func(ctx context.Context, call *Call) (json.RawMessage, error) {
+		start := time.Now()
+		result, err := next(ctx, call)
+
+		code := http.StatusOK
+		if err != nil {
+			code = 0
+			if apiErr, ok := errors.AsType[*Error](err); ok {
+				code = apiErr.Code
+			} else if reqErr, ok := errors.AsType[*RequestError](err); ok {
+				code = reqErr.StatusCode
+			}
+		}
+
+		m.apiRequests.add(labelPairs("method", call.Method, "code", strconv.Itoa(code)), 1)
+		m.apiDuration.observe(labelPairs("method", call.Method), time.Since(start).Seconds())
+
+		return result, err
+	}
+}
+
+// observeRetry records a wait before retrying method.
+func (m *Metrics) observeRetry(method string, wait time.Duration) {
+	if m == nil {
+		return
+	}
+
+	m.apiRetryWait.observe(labelPairs("method", method), wait.Seconds())
+}
+
+// observeUpdate records a processed update of kind.
+func (m *Metrics) observeUpdate(kind handleOn, lag, duration time.Duration, err error) {
+	if m == nil {
+		return
+	}
+
+	labels := labelPairs("kind", handleOnNames[kind])
+	if kind == 0 {
+		labels = labelPairs("kind", "unknown")
+	}
+
+	m.updates.add(labels, 1)
+	m.handlerTime.observe(labels, duration.Seconds())
+
+	if lag > 0 {
+		m.updateLag.observe(labels, lag.Seconds())
+	}
+
+	if err != nil {
+		m.handlerErrors.add(labels, 1)
+	}
+}
+
+// ServeHTTP writes the metrics in the Prometheus text exposition format.
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+	bw := bufio.NewWriter(w)
+	for _, family := range m.metricFamilies {
+		family.vec.write(bw, family.name, family.help)
+	}
+	_ = bw.Flush()
+}
+
+type metricFamily struct {
+	name string
+	help string
+	vec  metricVec
+}
+
+type metricVec interface {
+	write(w *bufio.Writer, name, help string)
+}
+
+// labelPairs renders name/value pairs as Prometheus labels.
+func labelPairs(pairs ...string) string {
+	var b strings.Builder
+
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if i != 0 {
+			b.WriteByte(',')
+		}
+
+		b.WriteString(pairs[i])
+		b.WriteString(`="`)
+		b.WriteString(labelEscaper.Replace(pairs[i+1]))
+		b.WriteByte('"')
+	}
+
+	return b.String()
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// counterVec is a counter partitioned by rendered labels.
+type counterVec struct {
+	values sync.Map // map[string]*atomic.Uint64
+}
+
+func (v *counterVec) add(labels string, n uint64) {
+	value, ok := v.values.Load(labels)
+	if !ok {
+		value, _ = v.values.LoadOrStore(labels, new(atomic.Uint64))
+	}
+
+	value.(*atomic.Uint64).Add(n)
+}
+
+func (v *counterVec) write(w *bufio.Writer, name, help string) {
+	writeMetricHeader(w, name, help, "counter")
+
+	for _, labels := range sortedKeys(&v.values) {
+		value, _ := v.values.Load(labels)
+		writeSample(w, name, labels, float64(value.(*atomic.Uint64).Load()))
+	}
+}
+
+// histogramVec is a histogram partitioned by rendered labels.
+type histogramVec struct {
+	values sync.Map // map[string]*histogram
+}
+
+type histogram struct {
+	counts  []atomic.Uint64 // per bucket, the last one is +Inf
+	sumBits atomic.Uint64
+}
+
+func (v *histogramVec) observe(labels string, value float64) {
+	h, ok := v.values.Load(labels)
+	if !ok {
+		h, _ = v.values.LoadOrStore(labels, &histogram{
+			counts: make([]atomic.Uint64, len(defaultMetricsBuckets)+1),
+		})
+	}
+
+	h.(*histogram).observe(value)
+}
+
+func (h *histogram) observe(value float64) {
+	i, _ := slices.BinarySearch(defaultMetricsBuckets, value)
+	h.counts[i].Add(1)
+
+	for {
+		old := h.sumBits.Load()
+		if h.sumBits.CompareAndSwap(old, math.Float64bits(math.Float64frombits(old)+value)) {
+			return
+		}
+	}
+}
+
+func (v *histogramVec) write(w *bufio.Writer, name, help string) {
+	writeMetricHeader(w, name, help, "histogram")
+
+	for _, labels := range sortedKeys(&v.values) {
+		value, _ := v.values.Load(labels)
+		h := value.(*histogram)
+
+		prefix := labels
+		if prefix != "" {
+			prefix += ","
+		}
+
+		var count uint64
+		for i := range h.counts {
+			count += h.counts[i].Load()
+
+			bound := "+Inf"
+			if i < len(defaultMetricsBuckets) {
+				bound = strconv.FormatFloat(defaultMetricsBuckets[i], 'g', -1, 64)
+			}
+
+			writeSample(w, name+"_bucket", prefix+`le="`+bound+`"`, float64(count))
+		}
+
+		writeSample(w, name+"_sum", labels, math.Float64frombits(h.sumBits.Load()))
+		writeSample(w, name+"_count", labels, float64(count))
+	}
+}
+
+func writeMetricHeader(w *bufio.Writer, name, help, typ string) {
+	_, _ = w.WriteString("# HELP " + name + " " + help + "\n")
+	_, _ = w.WriteString("# TYPE " + name + " " + typ + "\n")
+}
+
+func writeSample(w *bufio.Writer, name, labels string, value float64) {
+	_, _ = w.WriteString(name)
+	if labels != "" {
+		_, _ = w.WriteString("{" + labels + "}")
+	}
+	_ = w.WriteByte(' ')
+	_, _ = w.WriteString(strconv.FormatFloat(value, 'g', -1, 64))
+	_ = w.WriteByte('\n')
+}
+
+func sortedKeys(m *sync.Map) []string {
+	var keys []string
+
+	m.Range(func(key, _ any) bool {
+		keys = append(keys, key.(string))
+		return true
+	})
+
+	slices.Sort(keys)
+
+	return keys
+}
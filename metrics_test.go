@@ -0,0 +1,86 @@
+package gogram_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/darxnet/gogram"
+)
+
+func TestMetrics_PrometheusText(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(req.URL.Path, "/getMe") {
+				return jsonHTTPResponse(t, &gogram.Response{
+					ErrorCode:   http.StatusBadRequest,
+					Description: "Bad Request",
+					Result:      json.RawMessage(`null`),
+				}), nil
+			}
+
+			msg := gogram.Message{MessageID: 1}
+			return jsonHTTPResponse(t, &gogram.Response{OK: true, Result: mustMarshal(t, &msg)}), nil
+		}),
+	}
+
+	router := gogram.NewRouter()
+	router.HandleOnMessage(func(ctx *gogram.Context, _ *gogram.Message) error {
+		if _, err := ctx.SendMessage("pong"); err != nil {
+			return err
+		}
+		return errors.New("handler failed")
+	})
+
+	metrics := gogram.NewMetrics()
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost("example.invalid"),
+		gogram.WithHTTPClient(httpClient),
+		gogram.WithRouter(router),
+		gogram.WithMetrics(metrics),
+		// Setting the option again does not count calls twice.
+		gogram.WithMetrics(metrics),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	deliveries := make(chan gogram.Delivery, 1)
+	deliveries <- gogram.Delivery{Update: &gogram.Update{Message: &gogram.Message{Chat: gogram.Chat{ID: 1}}}}
+	close(deliveries)
+
+	if err = client.Run(t.Context(), gogram.ChannelSource(deliveries)); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if _, err = client.GetMe(t.Context(), nil); err == nil {
+		t.Fatal("expected GetMe error")
+	}
+
+	rec := httptest.NewRecorder()
+	metrics.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", http.NoBody))
+
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		t.Errorf("unexpected content type %q", ct)
+	}
+
+	body := rec.Body.String()
+	for _, want := range []string{
+		"# TYPE gogram_api_requests_total counter\n",
+		`gogram_api_requests_total{method="sendMessage",code="200"} 1` + "\n",
+		`gogram_api_requests_total{method="getMe",code="400"} 1` + "\n",
+		`gogram_api_request_duration_seconds_count{method="sendMessage"} 1` + "\n",
+		`gogram_api_request_duration_seconds_bucket{method="getMe",le="+Inf"} 1` + "\n",
+		`gogram_updates_total{kind="message"} 1` + "\n",
+		`gogram_handler_errors_total{kind="message"} 1` + "\n",
+		`gogram_handler_duration_seconds_count{kind="message"} 1` + "\n",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics output lacks %q:\n%s", want, body)
+		}
+	}
+}
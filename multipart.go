@@ -0,0 +1,451 @@
+package gogram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"path/filepath"
+	"time"
+
+	"github.com/valyala/bytebufferpool"
+)
+
+// multipartBufferLimit is the size up to which file contents are copied into
+// the request body; larger files are streamed from their readers.
+const multipartBufferLimit = 1 << 20
+
+// ErrBodyNotRewindable is returned when a request body has to be sent again,
+// e.g. to retry a rate limited call, but a streamed file cannot be rewound.
+var ErrBodyNotRewindable = errors.New("gogram: request body cannot be rewound")
+
+// ErrFileTooLarge is returned when an uploaded file exceeds the limit set with
+// [WithUploadLimit].
+var ErrFileTooLarge = errors.New("gogram: file is too large")
+
+// sniffLen is the number of bytes considered by [http.DetectContentType].
+const sniffLen = 512
+
+// multipartForm is a multipart/form-data request body built without a
+// goroutine. Fields and small files are written into pooled buffers, while
+// larger files are referenced and streamed from their readers when the body
+// is read. The body has a known length when the sizes of the streamed files
+// are known, and can be rewound for retries when they can be seeked.
+//
+// The form is a [sharedBody]: the buffers are released and the files closed
+// once the method and every HTTP request sending the form have closed it.
+type multipartForm struct {
+	*multipart.Writer
+
+	bodyRefs bodyRefs
+
+	parts    []formPart
+	buffered int64
+	files    []formFile
+	fileIDs  *formFileIDs
+
+	part int // index of the part being read
+
+	ctx      context.Context
+	method   string
+	progress func(UploadProgress)
+	interval time.Duration
+	sent     int64
+	reported time.Time
+	finished bool
+}
+
+// formFile is a file added to the form.
+type formFile struct {
+	reader io.Reader
+	param  string
+	name   string
+	size   int64 // -1 if unknown
+	limit  int64 // set by checkSize, 0 if unlimited
+}
+
+// formPart is either buffered data or a streamed file.
+type formPart struct {
+	data   *bytebufferpool.ByteBuffer
+	offset int // read position in data
+
+	reader  io.Reader
+	file    int   // index of the file in form.files
+	prefix  int64 // bytes of the file buffered before the part
+	start   int64 // position of reader at the start of the part
+	size    int64 // -1 if unknown
+	read    int64
+	seekErr error // why reader cannot be rewound
+}
+
+func newMultipartForm() *multipartForm {
+	form := new(multipartForm)
+	form.Writer = multipart.NewWriter(form)
+	form.bodyRefs.init(form.release)
+
+	return form
+}
+
+// Write appends p to the buffered part at the end of the body.
+func (form *multipartForm) Write(p []byte) (int, error) {
+	if len(form.parts) == 0 || form.parts[len(form.parts)-1].data == nil {
+		form.parts = append(form.parts, formPart{data: acquireBuffer()})
+	}
+
+	form.buffered += int64(len(p))
+
+	return form.parts[len(form.parts)-1].data.Write(p)
+}
+
+// addFile appends a part with the contents of f, opening it first if it was
+// created by a constructor such as [InputFileFromPath]. The part's content
+// type is detected from the first bytes unless f.ContentType is set. Files
+// that fit into multipartBufferLimit are copied, others are streamed when the
+// body is read. The file is closed by release.
+func (form *multipartForm) addFile(f *InputFile) error {
+	r := f.File
+	if f.open != nil {
+		var err error
+		if r, err = f.open(); err != nil {
+			return err
+		}
+	}
+
+	form.files = append(form.files, formFile{reader: r, param: f.fieldName, name: f.FileName, size: -1})
+	file := &form.files[len(form.files)-1]
+
+	var head [sniffLen]byte
+
+	n, err := io.ReadFull(r, head[:])
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return err
+	}
+
+	contentType := f.ContentType
+	if contentType == "" {
+		contentType = detectContentType(head[:n], f.FileName)
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", multipart.FileContentDisposition(f.fieldName, f.FileName))
+	header.Set("Content-Type", contentType)
+
+	if _, err = form.CreatePart(header); err != nil {
+		return err
+	}
+
+	_, _ = form.Write(head[:n])
+
+	if n < sniffLen {
+		file.size = int64(n)
+		return nil
+	}
+
+	size, known := readerSize(r)
+	if known {
+		file.size = int64(n) + size
+	}
+
+	if known && form.buffered+size > multipartBufferLimit {
+		form.streamFile(r, int64(n), size)
+		return nil
+	}
+
+	// Copy up to the limit, the rest is streamed if the size was unknown.
+	limit := max(multipartBufferLimit-form.buffered, 0)
+
+	copied, err := io.Copy(form, io.LimitReader(r, limit+1))
+	if err != nil {
+		return err
+	}
+
+	if copied > limit {
+		form.streamFile(r, int64(n)+copied, -1)
+	} else {
+		file.size = int64(n) + copied
+	}
+
+	return nil
+}
+
+// streamFile appends a part read from r, the last file, when the body is
+// read; prefix bytes of the file were buffered before it.
+func (form *multipartForm) streamFile(r io.Reader, prefix, size int64) {
+	part := formPart{reader: r, file: len(form.files) - 1, prefix: prefix, size: size}
+
+	if seeker, ok := r.(io.Seeker); ok {
+		start, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			part.seekErr = err
+		}
+
+		part.start = start
+	} else {
+		part.seekErr = fmt.Errorf("%T is not an io.Seeker", r)
+	}
+
+	form.parts = append(form.parts, part)
+}
+
+// finish writes the trailing boundary; the form is complete afterwards.
+func (form *multipartForm) finish() error {
+	return form.Writer.Close()
+}
+
+// body returns the request body after the form is finished.
+func (form *multipartForm) body() io.Reader {
+	return form
+}
+
+func (form *multipartForm) refs() *bodyRefs {
+	return &form.bodyRefs
+}
+
+// Close drops the reference of the method that built the form; it does not
+// finish the form like [multipart.Writer.Close].
+func (form *multipartForm) Close() error {
+	form.bodyRefs.drop()
+	return nil
+}
+
+// bind makes reading the body fail once ctx is done and report the progress
+// set with [ContextWithUploadProgress].
+func (form *multipartForm) bind(ctx context.Context, method string, opts callOptions) {
+	form.ctx = ctx
+	form.method = method
+	form.progress = opts.progress
+	form.interval = opts.progressInterval
+}
+
+// checkSize returns [ErrFileTooLarge] if a file of known size exceeds limit,
+// or [PhotoMaxSize] for the photo of sendPhoto. Files of unknown size are
+// checked as they are read.
+func (form *multipartForm) checkSize(method string, limit int64) error {
+	if limit <= 0 {
+		return nil
+	}
+
+	for i := range form.files {
+		file := &form.files[i]
+
+		file.limit = limit
+		if method == "sendPhoto" && file.param == "photo" {
+			file.limit = min(limit, PhotoMaxSize)
+		}
+
+		if file.size > file.limit {
+			return fmt.Errorf("%w: %s is %d bytes, the limit is %d", ErrFileTooLarge, file.name, file.size, file.limit)
+		}
+	}
+
+	return nil
+}
+
+// ContentLength returns the length of the body, or -1 if unknown.
+func (form *multipartForm) ContentLength() int64 {
+	length := int64(0)
+
+	for i := range form.parts {
+		part := &form.parts[i]
+
+		switch {
+		case part.data != nil:
+			length += int64(part.data.Len())
+
+		case part.size < 0:
+			return -1
+
+		default:
+			length += part.size
+		}
+	}
+
+	return length
+}
+
+// Read implements [io.Reader].
+func (form *multipartForm) Read(p []byte) (int, error) {
+	if form.ctx != nil {
+		if err := form.ctx.Err(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := form.read(p)
+
+	if form.progress != nil {
+		form.sent += int64(n)
+		form.reportProgress(errors.Is(err, io.EOF))
+	}
+
+	return n, err
+}
+
+// reportProgress reports the bytes sent at most every interval and once
+// when the whole body is sent.
+func (form *multipartForm) reportProgress(done bool) {
+	now := time.Now()
+	if form.finished || !done && now.Sub(form.reported) < form.interval {
+		return
+	}
+
+	form.reported = now
+	form.finished = done
+
+	form.progress(UploadProgress{Method: form.method, Sent: form.sent, Total: form.ContentLength()})
+}
+
+func (form *multipartForm) read(p []byte) (int, error) {
+	for form.part < len(form.parts) {
+		part := &form.parts[form.part]
+
+		if part.data != nil {
+			if part.offset < part.data.Len() {
+				n := copy(p, part.data.B[part.offset:])
+				part.offset += n
+
+				return n, nil
+			}
+
+			form.part++
+
+			continue
+		}
+
+		if part.size >= 0 && part.read >= part.size {
+			form.part++
+			continue
+		}
+
+		buf := p
+		if part.size >= 0 {
+			buf = p[:min(int64(len(p)), part.size-part.read)]
+		}
+
+		n, err := part.reader.Read(buf)
+		part.read += int64(n)
+
+		if file := form.files[part.file]; file.limit > 0 && part.prefix+part.read > file.limit {
+			return n, fmt.Errorf("%w: %s exceeds the limit of %d bytes", ErrFileTooLarge, file.name, file.limit)
+		}
+
+		if errors.Is(err, io.EOF) {
+			if part.size >= 0 && part.read < part.size {
+				return n, fmt.Errorf("gogram: file is shorter than its size: %w", io.ErrUnexpectedEOF)
+			}
+
+			form.part++
+			err = nil
+		}
+
+		if n != 0 || err != nil {
+			return n, err
+		}
+	}
+
+	return 0, io.EOF
+}
+
+// Seek rewinds the body for a retry; only Seek(0, io.SeekStart) is supported.
+func (form *multipartForm) Seek(offset int64, whence int) (int64, error) {
+	if offset != 0 || whence != io.SeekStart {
+		return 0, fmt.Errorf("%w: only rewinding is supported", ErrBodyNotRewindable)
+	}
+
+	for i := range form.parts {
+		part := &form.parts[i]
+		part.offset = 0
+
+		if part.data != nil || part.read == 0 {
+			continue
+		}
+
+		if part.seekErr != nil {
+			return 0, fmt.Errorf("%w: %w", ErrBodyNotRewindable, part.seekErr)
+		}
+
+		if _, err := part.reader.(io.Seeker).Seek(part.start, io.SeekStart); err != nil {
+			return 0, fmt.Errorf("%w: %w", ErrBodyNotRewindable, err)
+		}
+
+		part.read = 0
+	}
+
+	form.part = 0
+	form.sent = 0
+	form.reported = time.Time{}
+	form.finished = false
+
+	return 0, nil
+}
+
+// release returns the buffers to the pool and closes the files.
+func (form *multipartForm) release() {
+	for _, part := range form.parts {
+		if part.data != nil {
+			releaseBuffer(part.data)
+		}
+	}
+
+	form.parts = nil
+
+	for _, file := range form.files {
+		if closer, ok := file.reader.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}
+}
+
+// detectContentType returns the media type of a file starting with head,
+// falling back to the type registered for the extension of name.
+func detectContentType(head []byte, name string) string {
+	contentType := http.DetectContentType(head)
+	if contentType == "application/octet-stream" {
+		if byExt := mime.TypeByExtension(filepath.Ext(name)); byExt != "" {
+			return byExt
+		}
+	}
+
+	return contentType
+}
+
+// readerSize returns the number of bytes left in r, if it can be known
+// without reading it.
+func readerSize(r io.Reader) (int64, bool) {
+	switch v := r.(type) {
+	case interface{ Len() int }:
+		return int64(v.Len()), true
+
+	case io.Seeker:
+		current, err := v.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0, false
+		}
+
+		end, err := v.Seek(0, io.SeekEnd)
+		if err != nil {
+			return 0, false
+		}
+
+		if _, err = v.Seek(current, io.SeekStart); err != nil {
+			return 0, false
+		}
+
+		return end - current, true
+
+	case interface{ Stat() (fs.FileInfo, error) }:
+		info, err := v.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return 0, false
+		}
+
+		return info.Size(), true
+	}
+
+	return 0, false
+}
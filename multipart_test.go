@@ -0,0 +1,444 @@
+package gogram_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"testing/fstest"
+
+	"github.com/darxnet/gogram"
+)
+
+func TestClient_SendPhoto_MultipartBody(t *testing.T) {
+	t.Parallel()
+
+	large := bytes.Repeat([]byte("0123456789abcdef"), 1<<17) // 2 MiB
+
+	path := filepath.Join(t.TempDir(), "large.jpg")
+	if err := os.WriteFile(path, large, 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		open       func(t *testing.T) io.Reader
+		content    []byte
+		wantLength bool
+		wantRetry  bool
+	}{
+		{
+			name:       "Buffered",
+			open:       func(*testing.T) io.Reader { return bytes.NewReader([]byte("small photo")) },
+			content:    []byte("small photo"),
+			wantLength: true,
+			wantRetry:  true,
+		},
+		{
+			name: "StreamedFile",
+			open: func(t *testing.T) io.Reader {
+				f, err := os.Open(path)
+				if err != nil {
+					t.Fatalf("Open: %v", err)
+				}
+
+				return f
+			},
+			content:    large,
+			wantLength: true,
+			wantRetry:  true,
+		},
+		{
+			name: "StreamedUnknownSize",
+			open: func(*testing.T) io.Reader {
+				return io.MultiReader(bytes.NewReader(large[:1<<20]), bytes.NewReader(large[1<<20:]))
+			},
+			content: large,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu       sync.Mutex
+				attempts int
+			)
+
+			httpClient := &http.Client{
+				Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					body, err := io.ReadAll(req.Body)
+					_ = req.Body.Close()
+
+					if err != nil {
+						return nil, err
+					}
+
+					if tt.wantLength && req.ContentLength != int64(len(body)) {
+						t.Errorf("ContentLength = %d, body is %d bytes", req.ContentLength, len(body))
+					}
+					if !tt.wantLength && req.ContentLength > 0 {
+						t.Errorf("ContentLength = %d, want unknown", req.ContentLength)
+					}
+
+					_, params, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+					form, err := multipart.NewReader(bytes.NewReader(body), params["boundary"]).ReadForm(1 << 30)
+					if err != nil {
+						t.Fatalf("ReadForm: %v", err)
+					}
+
+					if got := form.Value["chat_id"]; len(got) != 1 || got[0] != "1" {
+						t.Errorf("chat_id = %v", got)
+					}
+
+					photo, err := form.File["photo"][0].Open()
+					if err != nil {
+						t.Fatalf("Open: %v", err)
+					}
+
+					if content, _ := io.ReadAll(photo); !bytes.Equal(content, tt.content) {
+						t.Errorf("photo content is %d bytes, want %d", len(content), len(tt.content))
+					}
+
+					mu.Lock()
+					attempts++
+					first := attempts == 1
+					mu.Unlock()
+
+					if first {
+						return jsonHTTPResponse(t, &gogram.Response{
+							ErrorCode:   http.StatusTooManyRequests,
+							Description: "Too Many Requests: retry after 1",
+							Result:      json.RawMessage(`null`),
+							Parameters:  &gogram.ResponseParameters{RetryAfter: 1},
+						}), nil
+					}
+
+					return jsonHTTPResponse(t, &gogram.Response{OK: true, Result: json.RawMessage(`{"message_id":1}`)}), nil
+				}),
+			}
+
+			client, err := gogram.NewClient(testToken,
+				gogram.WithHost("example.invalid"),
+				gogram.WithHTTPClient(httpClient),
+			)
+			if err != nil {
+				t.Fatalf("NewClient: %v", err)
+			}
+
+			file := tt.open(t)
+
+			_, err = client.SendPhoto(t.Context(), &gogram.SendPhotoParams{
+				ChatID: "1",
+				Photo:  gogram.InputFile{File: file, FileName: "photo.jpg"},
+			})
+
+			switch {
+			case tt.wantRetry && err != nil:
+				t.Fatalf("SendPhoto: %v", err)
+
+			case !tt.wantRetry && !errors.Is(err, gogram.ErrBodyNotRewindable):
+				t.Fatalf("expected ErrBodyNotRewindable, got %v", err)
+			}
+
+			if f, ok := file.(*os.File); ok {
+				if _, err := f.Read(make([]byte, 1)); !errors.Is(err, os.ErrClosed) {
+					t.Errorf("file not closed after upload: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestClient_SendPhoto_BodyReadAfterReturn(t *testing.T) {
+	t.Parallel()
+
+	large := bytes.Repeat([]byte("0123456789abcdef"), 1<<17) // 2 MiB
+
+	path := filepath.Join(t.TempDir(), "large.jpg")
+	if err := os.WriteFile(path, large, 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+
+	var late *http.Request
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost("example.invalid"),
+		gogram.WithHTTPClient(&http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				// Reject the upload before reading the body, like Telegram
+				// answering 413 to a large file.
+				late = req
+
+				return jsonHTTPResponse(t, &gogram.Response{
+					ErrorCode:   http.StatusBadRequest,
+					Description: "Bad Request: file is too big",
+					Result:      json.RawMessage(`null`),
+				}), nil
+			}),
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	_, err = client.SendPhoto(t.Context(), &gogram.SendPhotoParams{
+		ChatID: "1",
+		Photo:  gogram.InputFile{File: f, FileName: "large.jpg"},
+	})
+	if err == nil {
+		t.Fatal("SendPhoto succeeded, want the rejection")
+	}
+
+	// The Transport still owns the body after the call returned.
+	if _, err = f.Stat(); err != nil {
+		t.Errorf("file closed before the request body: %v", err)
+	}
+
+	_ = late.Body.Close()
+
+	if _, err := f.Read(make([]byte, 1)); !errors.Is(err, os.ErrClosed) {
+		t.Errorf("file not closed with the request body: %v", err)
+	}
+}
+
+func TestInputFile_Constructors(t *testing.T) {
+	t.Parallel()
+
+	png := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 1<<20)...)
+
+	path := filepath.Join(t.TempDir(), "photo.png")
+	if err := os.WriteFile(path, png, 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	fsys := fstest.MapFS{"static/photo.png": {Data: png}}
+
+	tests := []struct {
+		name string
+		file gogram.InputFile
+	}{
+		{name: "Path", file: gogram.InputFileFromPath(path)},
+		{name: "Bytes", file: gogram.InputFileFromBytes("photo.png", png)},
+		{name: "FS", file: gogram.InputFileFromFS(fsys, "static/photo.png")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts int
+
+			httpClient := &http.Client{
+				Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					_, params, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+					reader := multipart.NewReader(req.Body, params["boundary"])
+
+					for {
+						part, err := reader.NextPart()
+						if err != nil {
+							t.Fatalf("photo part not found: %v", err)
+						}
+
+						if part.FormName() != "photo" {
+							continue
+						}
+
+						if part.FileName() != "photo.png" {
+							t.Errorf("file name = %q", part.FileName())
+						}
+
+						if got := part.Header.Get("Content-Type"); got != "image/png" {
+							t.Errorf("Content-Type = %q, want image/png", got)
+						}
+
+						if content, _ := io.ReadAll(part); !bytes.Equal(content, png) {
+							t.Errorf("photo content is %d bytes, want %d", len(content), len(png))
+						}
+
+						break
+					}
+
+					attempts++
+					if attempts%2 == 1 {
+						return jsonHTTPResponse(t, &gogram.Response{
+							ErrorCode:   http.StatusTooManyRequests,
+							Description: "Too Many Requests: retry after 1",
+							Result:      json.RawMessage(`null`),
+							Parameters:  &gogram.ResponseParameters{RetryAfter: 1},
+						}), nil
+					}
+
+					return jsonHTTPResponse(t, &gogram.Response{OK: true, Result: json.RawMessage(`{"message_id":1}`)}), nil
+				}),
+			}
+
+			client, err := gogram.NewClient(testToken,
+				gogram.WithHost("example.invalid"),
+				gogram.WithHTTPClient(httpClient),
+			)
+			if err != nil {
+				t.Fatalf("NewClient: %v", err)
+			}
+
+			// The same file is sent twice, each time after a retry.
+			for range 2 {
+				if _, err = client.SendPhoto(t.Context(), &gogram.SendPhotoParams{ChatID: "1", Photo: tt.file}); err != nil {
+					t.Fatalf("SendPhoto: %v", err)
+				}
+			}
+
+			if attempts != 4 {
+				t.Errorf("got %d requests, want 4", attempts)
+			}
+		})
+	}
+}
+
+func TestClient_SendDocument_UploadLimit(t *testing.T) {
+	t.Parallel()
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost("example.invalid"),
+		gogram.WithUploadLimit(1<<20),
+		gogram.WithHTTPClient(&http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				if _, err := io.Copy(io.Discard, req.Body); err != nil {
+					return nil, err
+				}
+
+				return jsonHTTPResponse(t, &gogram.Response{OK: true, Result: json.RawMessage(`{"message_id":1}`)}), nil
+			}),
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	large := make([]byte, 1<<20+1)
+
+	for name, file := range map[string]gogram.InputFile{
+		"KnownSize":   gogram.InputFileFromBytes("large.bin", large),
+		"UnknownSize": {File: io.MultiReader(bytes.NewReader(large)), FileName: "large.bin"},
+	} {
+		_, err = client.SendDocument(t.Context(), &gogram.SendDocumentParams{ChatID: "1", Document: file})
+		if !errors.Is(err, gogram.ErrFileTooLarge) {
+			t.Errorf("%s: expected ErrFileTooLarge, got %v", name, err)
+		}
+	}
+
+	_, err = client.SendDocument(t.Context(), &gogram.SendDocumentParams{
+		ChatID:   "1",
+		Document: gogram.InputFileFromBytes("small.bin", large[:1<<20]),
+	})
+	if err != nil {
+		t.Fatalf("SendDocument: %v", err)
+	}
+}
+
+func TestClient_SendDocument_LocalServerUploadLimit(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	httpClient := &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			requests.Add(1)
+
+			if _, err := io.Copy(io.Discard, req.Body); err != nil {
+				return nil, err
+			}
+
+			return jsonHTTPResponse(t, &gogram.Response{OK: true, Result: json.RawMessage(`{"message_id":1}`)}), nil
+		}),
+	}
+
+	large := make([]byte, gogram.UploadMaxSize+1)
+
+	for _, tt := range []struct {
+		name    string
+		opts    []gogram.ClientOption
+		wantErr error
+	}{
+		{name: "Bot API", wantErr: gogram.ErrFileTooLarge},
+		{name: "local server", opts: []gogram.ClientOption{gogram.WithHost("localhost:8081")}},
+		{
+			name:    "local server with limit",
+			opts:    []gogram.ClientOption{gogram.WithUploadLimit(gogram.UploadMaxSize), gogram.WithHost("localhost:8081")},
+			wantErr: gogram.ErrFileTooLarge,
+		},
+	} {
+		client, err := gogram.NewClient(testToken, append(tt.opts, gogram.WithHTTPClient(httpClient))...)
+		if err != nil {
+			t.Fatalf("NewClient: %v", err)
+		}
+
+		_, err = client.SendDocument(t.Context(), &gogram.SendDocumentParams{
+			ChatID:   "1",
+			Document: gogram.InputFileFromBytes("large.bin", large),
+		})
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: SendDocument = %v, want %v", tt.name, err, tt.wantErr)
+		}
+	}
+
+	if n := requests.Load(); n != 1 {
+		t.Errorf("got %d requests, want 1", n)
+	}
+}
+
+func TestClient_SendPhoto_PhotoLimit(t *testing.T) {
+	t.Parallel()
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost("example.invalid"),
+		gogram.WithHTTPClient(&http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				defer req.Body.Close()
+
+				if _, err := io.Copy(io.Discard, req.Body); err != nil {
+					return nil, err
+				}
+
+				return jsonHTTPResponse(t, &gogram.Response{OK: true, Result: json.RawMessage(`{"message_id":1}`)}), nil
+			}),
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	large := make([]byte, gogram.PhotoMaxSize+1)
+
+	for name, file := range map[string]gogram.InputFile{
+		"KnownSize":   gogram.InputFileFromBytes("large.jpg", large),
+		"UnknownSize": {File: io.MultiReader(bytes.NewReader(large)), FileName: "large.jpg"},
+	} {
+		_, err = client.SendPhoto(t.Context(), &gogram.SendPhotoParams{ChatID: "1", Photo: file})
+		if !errors.Is(err, gogram.ErrFileTooLarge) {
+			t.Errorf("%s: expected ErrFileTooLarge, got %v", name, err)
+		}
+	}
+
+	// Other files are only limited by UploadMaxSize.
+	_, err = client.SendDocument(t.Context(), &gogram.SendDocumentParams{
+		ChatID:   "1",
+		Document: gogram.InputFileFromBytes("large.jpg", large),
+	})
+	if err != nil {
+		t.Fatalf("SendDocument: %v", err)
+	}
+}
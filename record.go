@@ -0,0 +1,340 @@
+package gogram
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrReplayExhausted indicates that a replayed session has no more recorded
+// responses for the requested method.
+var ErrReplayExhausted = errors.New("gogram: no recorded response left")
+
+// Recorded entry types.
+const (
+	RecordTypeCall   = "call"
+	RecordTypeUpdate = "update"
+)
+
+// RecordEntry is a line of a session written by [Recorder].
+type RecordEntry struct {
+	Type string    `json:"type"`
+	Time time.Time `json:"time"`
+
+	// Update is set for [RecordTypeUpdate] entries.
+	Update *Update `json:"update,omitempty"`
+
+	// Method is the Bot API method of a [RecordTypeCall] entry.
+	Method string `json:"method,omitempty"`
+	// Params is the JSON request body; it is omitted for multipart requests.
+	Params json.RawMessage `json:"params,omitempty"`
+	// Status and Response are the HTTP status code and body of the response.
+	Status   int             `json:"status,omitempty"`
+	Response json.RawMessage `json:"response,omitempty"`
+	// Error is the transport error, if no response was received.
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"duration,omitempty"`
+}
+
+// Recorder is an [http.RoundTripper] writing every Bot API request and its
+// response to a JSONL session, one [RecordEntry] per line, with the bot
+// token redacted. Updates are recorded by wrapping the source with
+// [Recorder.Source]:
+//
+//	rec := gogram.NewRecorder(file, http.DefaultTransport)
+//	client, _ := gogram.NewClient(token, gogram.WithHTTPClient(&http.Client{Transport: rec}))
+//	err := client.Run(ctx, rec.Source(client.PollingSource(nil)))
+//
+// The session is served back by [Replay]. Requests other than API calls,
+// e.g. file downloads, are passed through without recording.
+type Recorder struct {
+	next http.RoundTripper
+
+	mu  sync.Mutex
+	w   io.Writer
+	err error
+}
+
+// NewRecorder creates a Recorder sending requests with next, or
+// [http.DefaultTransport] if nil, and writing the session to w.
+func NewRecorder(w io.Writer, next http.RoundTripper) *Recorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &Recorder{next: next, w: w}
+}
+
+// Err returns the first error that occurred writing the session.
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.err
+}
+
+// RoundTrip implements [http.RoundTripper].
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, method, ok := splitAPIPath(req.URL.Path)
+	if !ok {
+		return r.next.RoundTrip(req)
+	}
+
+	entry := RecordEntry{
+		Type:   RecordTypeCall,
+		Time:   time.Now(),
+		Method: method,
+	}
+
+	if req.Body != nil && strings.HasPrefix(req.Header.Get("Content-Type"), "application/json") {
+		// Read the body itself rather than a copy from GetBody, which may
+		// rewind and share the reader being sent.
+		params, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		entry.Params = bytes.TrimSpace(params)
+
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(params))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(params)), nil
+		}
+	}
+
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		entry.Duration = time.Since(entry.Time)
+		entry.Error = err.Error()
+		r.write(&entry, token)
+
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	entry.Duration = time.Since(entry.Time)
+	if err != nil {
+		entry.Error = err.Error()
+		r.write(&entry, token)
+
+		return nil, err
+	}
+
+	entry.Status = resp.StatusCode
+	if json.Valid(body) {
+		entry.Response = body
+	}
+	r.write(&entry, token)
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return resp, nil
+}
+
+// Source wraps source so that every received update is recorded.
+func (r *Recorder) Source(source UpdateSource) UpdateSource {
+	return &recordingSource{recorder: r, source: source}
+}
+
+func (r *Recorder) write(entry *RecordEntry, token string) {
+	line, err := json.Marshal(entry)
+	if err == nil && token != "" {
+		line = bytes.ReplaceAll(line, []byte(token), []byte(redactedToken))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.err != nil {
+		return
+	}
+
+	if err == nil {
+		_, err = r.w.Write(append(line, '\n'))
+	}
+
+	r.err = err
+}
+
+type recordingSource struct {
+	recorder *Recorder
+	source   UpdateSource
+}
+
+func (s *recordingSource) Receive(ctx context.Context, deliver DeliverFunc) error {
+	return s.source.Receive(ctx, func(ctx context.Context, update *Update, ack Ack) error {
+		s.recorder.write(&RecordEntry{Type: RecordTypeUpdate, Time: time.Now(), Update: update}, "")
+		return deliver(ctx, update, ack)
+	})
+}
+
+// splitAPIPath splits a "/bot<token>/<method>" path.
+func splitAPIPath(path string) (token, method string, ok bool) {
+	rest, ok := strings.CutPrefix(path, "/bot")
+	if !ok {
+		return "", "", false
+	}
+
+	token, method, ok = strings.Cut(rest, "/")
+	if !ok {
+		return "", "", false
+	}
+
+	// Test environment requests are sent to /bot<token>/test/<method>.
+	method = strings.TrimPrefix(method, "test/")
+
+	return token, method, method != "" && !strings.Contains(method, "/")
+}
+
+// Replay serves a session written by [Recorder]. It is an
+// [http.RoundTripper] answering each Bot API request with the next recorded
+// response for its method, so the responses do not depend on the order
+// requests of different methods are made in. [Replay.Source] delivers the
+// recorded updates:
+//
+//	replay, _ := gogram.NewReplay(file)
+//	client, _ := gogram.NewClient(token,
+//		gogram.WithHTTPClient(&http.Client{Transport: replay}),
+//		gogram.WithRouter(router),
+//	)
+//	err := client.Run(ctx, replay.Source())
+type Replay struct {
+	mu      sync.Mutex
+	calls   map[string][]RecordEntry
+	updates []Update
+}
+
+// NewReplay reads a session written by [Recorder] from r.
+func NewReplay(r io.Reader) (*Replay, error) {
+	replay := &Replay{calls: make(map[string][]RecordEntry)}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, defaultJSONLineMaxBytes)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var entry RecordEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, err
+		}
+
+		switch entry.Type {
+		case RecordTypeCall:
+			replay.calls[entry.Method] = append(replay.calls[entry.Method], entry)
+
+		case RecordTypeUpdate:
+			if entry.Update != nil {
+				replay.updates = append(replay.updates, *entry.Update)
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return replay, nil
+}
+
+// Updates returns the recorded updates in order.
+func (r *Replay) Updates() []Update {
+	return r.updates
+}
+
+// Pending returns the number of recorded responses not served yet.
+func (r *Replay) Pending() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := 0
+	for _, calls := range r.calls {
+		n += len(calls)
+	}
+
+	return n
+}
+
+// RoundTrip implements [http.RoundTripper].
+func (r *Replay) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+
+	_, method, ok := splitAPIPath(req.URL.Path)
+	if !ok {
+		return nil, fmt.Errorf("%w: not a Bot API request", ErrReplayExhausted)
+	}
+
+	r.mu.Lock()
+	calls := r.calls[method]
+	if len(calls) == 0 {
+		r.mu.Unlock()
+		return nil, fmt.Errorf("%w: %s", ErrReplayExhausted, method)
+	}
+	entry := calls[0]
+	r.calls[method] = calls[1:]
+	r.mu.Unlock()
+
+	if entry.Error != "" {
+		return nil, errors.New(entry.Error) //nolint:err113 // recorded transport error
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", entry.Status, http.StatusText(entry.Status)),
+		StatusCode:    entry.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(entry.Response)),
+		ContentLength: int64(len(entry.Response)),
+		Request:       req,
+	}, nil
+}
+
+// Source returns an [UpdateSource] delivering the recorded updates one by
+// one, each after the previous one is processed, so that a router re-run
+// makes its calls in the recorded order.
+func (r *Replay) Source() UpdateSource {
+	return replaySource{updates: r.updates}
+}
+
+type replaySource struct {
+	updates []Update
+}
+
+func (s replaySource) Receive(ctx context.Context, deliver DeliverFunc) error {
+	for i := range s.updates {
+		update := s.updates[i]
+		done := make(chan struct{})
+
+		if err := deliver(ctx, &update, func(error) { close(done) }); err != nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-done:
+		}
+	}
+
+	return nil
+}
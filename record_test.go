@@ -0,0 +1,143 @@
+package gogram_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/darxnet/gogram"
+)
+
+func TestRecorder_ReplaySession(t *testing.T) {
+	t.Parallel()
+
+	var sent atomic.Int64
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		if !bytes.Contains(body, []byte("echo: hi")) {
+			t.Errorf("unexpected request body %s", body)
+		}
+
+		msg := gogram.Message{MessageID: sent.Add(1)}
+		return jsonHTTPResponse(t, &gogram.Response{OK: true, Result: mustMarshal(t, &msg)}), nil
+	})
+
+	newClient := func(transport http.RoundTripper, ids *[]int64) *gogram.Client {
+		router := gogram.NewRouter()
+		router.HandleOnMessage(func(ctx *gogram.Context, msg *gogram.Message) error {
+			reply, err := ctx.SendMessage("echo: " + msg.Text)
+			if err != nil {
+				return err
+			}
+			*ids = append(*ids, reply.MessageID)
+			return nil
+		})
+
+		client, err := gogram.NewClient(testToken,
+			gogram.WithHost("example.invalid"),
+			gogram.WithHTTPClient(&http.Client{Transport: transport}),
+			gogram.WithRouter(router),
+		)
+		if err != nil {
+			t.Fatalf("NewClient: %v", err)
+		}
+
+		return client
+	}
+
+	var session bytes.Buffer
+	rec := gogram.NewRecorder(&session, transport)
+
+	var recorded []int64
+	client := newClient(rec, &recorded)
+
+	deliveries := make(chan gogram.Delivery, 2)
+	for id := range int64(2) {
+		deliveries <- gogram.Delivery{Update: &gogram.Update{
+			UpdateID: id + 1,
+			Message:  &gogram.Message{Chat: gogram.Chat{ID: 1}, Text: "hi"},
+		}}
+	}
+	close(deliveries)
+
+	if err := client.Run(t.Context(), rec.Source(gogram.ChannelSource(deliveries))); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if err := rec.Err(); err != nil {
+		t.Fatalf("Recorder: %v", err)
+	}
+
+	if strings.Contains(session.String(), testToken) {
+		t.Fatal("session contains the bot token")
+	}
+	if lines := strings.Count(session.String(), "\n"); lines != 4 {
+		t.Fatalf("expected 4 recorded entries, got %d:\n%s", lines, session.String())
+	}
+
+	replay, err := gogram.NewReplay(&session)
+	if err != nil {
+		t.Fatalf("NewReplay: %v", err)
+	}
+	if n := len(replay.Updates()); n != 2 {
+		t.Fatalf("expected 2 replayed updates, got %d", n)
+	}
+
+	var replayed []int64
+	client = newClient(replay, &replayed)
+
+	if err = client.Run(t.Context(), replay.Source()); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if n := replay.Pending(); n != 0 {
+		t.Errorf("expected all responses to be served, %d left", n)
+	}
+	if want := []int64{1, 2}; len(replayed) != 2 || replayed[0] != want[0] || replayed[1] != want[1] {
+		t.Errorf("replayed message ids %v, want %v", replayed, want)
+	}
+
+	_, err = client.GetMe(t.Context(), nil)
+	if !errors.Is(err, gogram.ErrReplayExhausted) {
+		t.Errorf("expected ErrReplayExhausted, got %v", err)
+	}
+}
+
+func TestRecorder_SeekableBody(t *testing.T) {
+	t.Parallel()
+
+	const params = `{"chat_id":"1","text":"hi"}`
+
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if body, _ := io.ReadAll(req.Body); string(body) != params {
+			t.Errorf("sent body %q, want %q", body, params)
+		}
+
+		return jsonHTTPResponse(t, &gogram.Response{OK: true, Result: mustMarshal(t, &gogram.Message{MessageID: 1})}), nil
+	})
+
+	var session bytes.Buffer
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost("example.invalid"),
+		gogram.WithHTTPClient(&http.Client{Transport: gogram.NewRecorder(&session, transport)}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	// A seeker other than the readers net/http knows gets a GetBody that
+	// rewinds the body being sent.
+	body := struct{ io.ReadSeeker }{strings.NewReader(params)}
+
+	if _, err = client.Raw(t.Context(), "sendMessage", body, "application/json", nil); err != nil {
+		t.Fatalf("Raw: %v", err)
+	}
+
+	if !strings.Contains(session.String(), `"params":`+params) {
+		t.Errorf("params not recorded:\n%s", session.String())
+	}
+}
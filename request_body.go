@@ -0,0 +1,135 @@
+package gogram
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/valyala/bytebufferpool"
+)
+
+// errBodySuperseded is returned by the body of an HTTP request that was
+// replaced by a retry.
+var errBodySuperseded = errors.New("gogram: request body was rewound for another request")
+
+// sharedBody is a request body in pooled memory, shared by the method that
+// built it and the HTTP requests sending it. The Transport may keep reading a
+// request body after RoundTrip returns, e.g. when Telegram answers before the
+// whole body is sent, so the body is released by whichever closes it last.
+type sharedBody interface {
+	io.ReadSeeker
+	io.Closer
+
+	refs() *bodyRefs
+}
+
+// bodyRefs counts the references to a sharedBody: one of the method building
+// it and one of each HTTP request still sending it. Rewinding the body for a
+// new request supersedes the previous ones, whose reads then fail, so that a
+// late read never interleaves with the new request.
+type bodyRefs struct {
+	count   atomic.Int32
+	release func()
+
+	mu         sync.Mutex // serializes reads and rewinds
+	generation int
+}
+
+func (r *bodyRefs) init(release func()) {
+	r.count.Store(1)
+	r.release = release
+}
+
+// drop releases the body when the last reference is dropped.
+func (r *bodyRefs) drop() {
+	if r.count.Add(-1) == 0 {
+		r.release()
+	}
+}
+
+// bodyAttempt is the body of one HTTP request sending a sharedBody. It holds
+// a reference to the body until the Transport closes it.
+type bodyAttempt struct {
+	body       sharedBody
+	generation int
+	once       sync.Once
+}
+
+func newBodyAttempt(body sharedBody) *bodyAttempt {
+	refs := body.refs()
+	refs.count.Add(1)
+
+	refs.mu.Lock()
+	defer refs.mu.Unlock()
+
+	return &bodyAttempt{body: body, generation: refs.generation}
+}
+
+// Read implements [io.Reader].
+func (a *bodyAttempt) Read(p []byte) (int, error) {
+	refs := a.body.refs()
+
+	refs.mu.Lock()
+	defer refs.mu.Unlock()
+
+	if a.generation != refs.generation {
+		return 0, errBodySuperseded
+	}
+
+	return a.body.Read(p)
+}
+
+// Close implements [io.Closer]; it is called by the Transport once it is done
+// with the request body.
+func (a *bodyAttempt) Close() error {
+	a.once.Do(a.body.refs().drop)
+	return nil
+}
+
+// rewindBody seeks body to the start for a new request, superseding the
+// requests sending it before.
+func rewindBody(body sharedBody) error {
+	refs := body.refs()
+
+	refs.mu.Lock()
+	defer refs.mu.Unlock()
+
+	refs.generation++
+
+	_, err := body.Seek(0, io.SeekStart)
+
+	return err
+}
+
+// bufferBody is a request body read from a pooled buffer, which is returned
+// to the pool once the body is closed by the method and every request.
+type bufferBody struct {
+	bytes.Reader
+
+	bodyRefs bodyRefs
+}
+
+func newBufferBody(buffer *bytebufferpool.ByteBuffer) *bufferBody {
+	body := new(bufferBody)
+	body.Reset(buffer.B)
+	body.bodyRefs.init(func() { releaseBuffer(buffer) })
+
+	return body
+}
+
+func (b *bufferBody) refs() *bodyRefs {
+	return &b.bodyRefs
+}
+
+// ContentLength returns the length of the body.
+func (b *bufferBody) ContentLength() int64 {
+	return b.Size()
+}
+
+// Close drops the reference of the method that built the body.
+func (b *bufferBody) Close() error {
+	b.bodyRefs.drop()
+	return nil
+}
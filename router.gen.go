@@ -35,6 +35,36 @@ const (
 	handleOnCount
 )
 
+// handleOnNames maps update kinds to their allowed_updates names.
+var handleOnNames = [handleOnCount]string{
+	handleOnMessage:                 "message",
+	handleOnEditedMessage:           "edited_message",
+	handleOnChannelPost:             "channel_post",
+	handleOnEditedChannelPost:       "edited_channel_post",
+	handleOnBusinessConnection:      "business_connection",
+	handleOnBusinessMessage:         "business_message",
+	handleOnEditedBusinessMessage:   "edited_business_message",
+	handleOnDeletedBusinessMessages: "deleted_business_messages",
+	handleOnGuestMessage:            "guest_message",
+	handleOnMessageReaction:         "message_reaction",
+	handleOnMessageReactionCount:    "message_reaction_count",
+	handleOnInlineQuery:             "inline_query",
+	handleOnChosenInlineResult:      "chosen_inline_result",
+	handleOnCallbackQuery:           "callback_query",
+	handleOnShippingQuery:           "shipping_query",
+	handleOnPreCheckoutQuery:        "pre_checkout_query",
+	handleOnPurchasedPaidMedia:      "purchased_paid_media",
+	handleOnPoll:                    "poll",
+	handleOnPollAnswer:              "poll_answer",
+	handleOnMyChatMember:            "my_chat_member",
+	handleOnChatMember:              "chat_member",
+	handleOnChatJoinRequest:         "chat_join_request",
+	handleOnChatBoost:               "chat_boost",
+	handleOnRemovedChatBoost:        "removed_chat_boost",
+	handleOnManagedBot:              "managed_bot",
+	handleOnSubscription:            "subscription",
+}
+
 // HandleOnMessage registers a handler for updates containing Message.
 func (rg *RouterGroup) HandleOnMessage(handler func(*Context, *Message) error, filters ...Filter) {
 	fn := func(ctx *Context) error {
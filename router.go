@@ -1,7 +1,7 @@
 package gogram
 
 import (
-	"log"
+	"log/slog"
 	"slices"
 	"strings"
 )
@@ -66,9 +66,12 @@ func (r *Router) HandleErr(ctx *Context, err error) {
 }
 
 // HandlePanic implements [Processor].
+// Without a panic handler, the panic is logged with the client logger.
 func (r *Router) HandlePanic(ctx *Context, v any) {
 	if r.handlerPanic != nil {
 		r.handlerPanic(ctx, v)
+	} else {
+		ctx.client.logPanic(ctx, v)
 	}
 }
 
@@ -91,10 +94,15 @@ func (r *Router) retrieveCommand(text string) string {
 	return text
 }
 
-// Process processes an update.
+// Process processes an update. With a [Tracer] set on the client, it runs
+// the handlers within an update span.
 //
 //nolint:gocognit // Dispatching Telegram's mutually exclusive update variants requires one explicit decision chain.
 func (r *Router) Process(ctx *Context) {
+	if c := ctx.client; c != nil && c.cfg.tracer != nil {
+		defer c.traceUpdate(ctx)()
+	}
+
 	defer r.handlePanic(ctx)
 
 	on := ctx.findHandlerOn()
@@ -152,19 +160,60 @@ func (r *Router) Process(ctx *Context) {
 }
 
 func (r *Router) handleErr(ctx *Context, err error) {
-	if err != nil && r.handlerErr != nil {
+	if err == nil {
+		return
+	}
+
+	ctx.handlerErr = err
+
+	if r.handlerErr != nil {
 		r.handlerErr(ctx, err)
+	} else {
+		ctx.client.log(ctx, slog.LevelError, "gogram: handler failed", slog.Any("error", err))
 	}
 }
 
 func (r *Router) handlePanic(ctx *Context) {
 	if v := recover(); v != nil {
+		ctx.handlerErr = &PanicError{Value: v}
+
 		if r.handlerPanic != nil {
 			r.handlerPanic(ctx, v)
 		} else {
-			log.Println("gogram: recovered panic:", v)
+			ctx.client.logPanic(ctx, v)
+		}
+	}
+}
+
+// AllowedUpdates returns the update types the router has handlers for, in the
+// form expected by [GetUpdatesParams.AllowedUpdates]. When a default handler
+// is set, every update type is returned. It returns nil for an empty router.
+func (r *Router) AllowedUpdates() []string {
+	var mask uint64
+
+	for on := range handleOnCount {
+		if len(r.handlersOn[on]) != 0 || r.handlerDefault != nil {
+			mask |= 1 << on
 		}
 	}
+
+	if len(r.handlersCommands) != 0 {
+		mask |= commandHandlersMask
+	}
+
+	if len(r.handlersCallbacks) != 0 {
+		mask |= 1 << handleOnCallbackQuery
+	}
+
+	var names []string
+
+	for on := range handleOnCount {
+		if mask&(1<<on) != 0 && handleOnNames[on] != "" {
+			names = append(names, handleOnNames[on])
+		}
+	}
+
+	return names
 }
 
 // SetHandlerDefault sets the default handler for updates that don't match any route.
@@ -322,3 +322,22 @@ func TestFilterByID(t *testing.T) {
 		t.Error("FilterChat(999) should be false")
 	}
 }
+
+func TestRouter_AllowedUpdates(t *testing.T) {
+	t.Parallel()
+
+	r := gogram.NewRouter()
+	if got := r.AllowedUpdates(); got != nil {
+		t.Errorf("empty router: got %v, want nil", got)
+	}
+
+	r.HandleCommand("start", func(*gogram.Context, *gogram.Message) error { return nil })
+	r.HandleOnChatMember(func(*gogram.Context, *gogram.ChatMemberUpdated) error { return nil })
+	r.HandleInlineKeyboardButton(&gogram.InlineKeyboardButton{CallbackData: "data"},
+		func(*gogram.Context, *gogram.CallbackQuery) error { return nil })
+
+	want := []string{"message", "channel_post", "business_message", "callback_query", "chat_member"}
+	if got := r.AllowedUpdates(); !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,150 @@
+package gogram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// Attribute keys set on spans.
+const (
+	AttrUpdateID   = "telegram.update_id"
+	AttrUpdateKind = "telegram.update_kind"
+	AttrChatID     = "telegram.chat_id"
+	AttrUserID     = "telegram.user_id"
+	AttrMethod     = "telegram.method"
+	AttrErrorType  = "error.type"
+)
+
+// Attribute is a span attribute. Value is an int64, string or bool.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+// Tracer starts spans. Its shape follows OpenTelemetry's trace.Tracer, so an
+// adapter is a few lines, but gogram does not depend on it. The returned
+// context carries the span and is the parent of spans started from it.
+type Tracer interface {
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Span is a unit of traced work started by a [Tracer].
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+// WithTracer traces updates and API calls with tracer. [Router.Process]
+// starts an "update <kind>" span and propagates it through the
+// [Context], so that the "<method>" spans of API calls made by the
+// handler, including [Context.Detach] copies, are its children. API calls
+// are traced by an interceptor running after the ones set with
+// [WithInterceptors]. A later WithTracer replaces tracer.
+func WithTracer(tracer Tracer) ClientOption {
+	return func(c *Client) {
+		c.cfg.tracer = tracer
+	}
+}
+
+func (c *Client) traceCall(next Invoker) Invoker {
+	return func(ctx context.Context, call *Call) (json.RawMessage, error) {
+		ctx, span := c.cfg.tracer.Start(ctx, call.Method, Attribute{Key: AttrMethod, Value: call.Method})
+		defer span.End()
+
+		result, err := next(ctx, call)
+		if err != nil {
+			span.RecordError(err)
+			span.SetAttributes(Attribute{Key: AttrErrorType, Value: ErrorType(err)})
+		}
+
+		return result, err
+	}
+}
+
+// traceUpdate starts the span of the update processed with ctx and makes it
+// the parent of calls made with ctx. The returned function ends the span.
+func (c *Client) traceUpdate(ctx *Context) func() {
+	on := ctx.findHandlerOn()
+	kind := handleOnNames[on]
+	if on == 0 {
+		kind = "unknown"
+	}
+
+	attrs := []Attribute{{Key: AttrUpdateKind, Value: kind}}
+	if ctx.update != nil {
+		attrs = append(attrs, Attribute{Key: AttrUpdateID, Value: ctx.update.UpdateID})
+	}
+	if chat := ctx.Chat(); chat != nil {
+		attrs = append(attrs, Attribute{Key: AttrChatID, Value: chat.ID})
+	}
+	if user := ctx.User(); user != nil {
+		attrs = append(attrs, Attribute{Key: AttrUserID, Value: user.ID})
+	}
+
+	parent := ctx.context
+	spanCtx, span := c.cfg.tracer.Start(parent, "update "+kind, attrs...)
+	ctx.context = spanCtx
+
+	return func() {
+		if err := ctx.handlerErr; err != nil {
+			span.RecordError(err)
+			span.SetAttributes(Attribute{Key: AttrErrorType, Value: ErrorType(err)})
+		}
+
+		ctx.context = parent
+		span.End()
+	}
+}
+
+// ErrorType classifies err for metrics and traces: "panic", "canceled",
+// "timeout", "rate_limited", "network", a Bot API error class such as
+// "bad_request" or "forbidden", or "error" for anything else.
+func ErrorType(err error) string {
+	if _, ok := errors.AsType[*PanicError](err); ok {
+		return "panic"
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	}
+
+	if apiErr, ok := errors.AsType[*Error](err); ok {
+		switch apiErr.Code {
+		case http.StatusBadRequest:
+			return "bad_request"
+
+		case http.StatusUnauthorized:
+			return "unauthorized"
+
+		case http.StatusForbidden:
+			return "forbidden"
+
+		case http.StatusNotFound:
+			return "not_found"
+
+		case http.StatusConflict:
+			return "conflict"
+
+		case http.StatusTooManyRequests:
+			return "rate_limited"
+
+		default:
+			if apiErr.Code >= http.StatusInternalServerError {
+				return "server_error"
+			}
+		}
+	}
+
+	if reqErr, ok := errors.AsType[*RequestError](err); ok && reqErr.StatusCode == 0 {
+		return "network"
+	}
+
+	return "error"
+}
@@ -0,0 +1,134 @@
+package gogram_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/darxnet/gogram"
+)
+
+type testSpan struct {
+	name   string
+	parent *testSpan
+	attrs  map[string]any
+	err    error
+	ended  bool
+}
+
+func (s *testSpan) SetAttributes(attrs ...gogram.Attribute) {
+	for _, attr := range attrs {
+		s.attrs[attr.Key] = attr.Value
+	}
+}
+
+func (s *testSpan) RecordError(err error) { s.err = err }
+
+func (s *testSpan) End() { s.ended = true }
+
+type testSpanKey struct{}
+
+type testTracer struct {
+	mu    sync.Mutex
+	spans []*testSpan
+}
+
+func (tr *testTracer) Start(ctx context.Context, name string, attrs ...gogram.Attribute) (context.Context, gogram.Span) {
+	parent, _ := ctx.Value(testSpanKey{}).(*testSpan)
+	span := &testSpan{name: name, parent: parent, attrs: make(map[string]any)}
+	span.SetAttributes(attrs...)
+
+	tr.mu.Lock()
+	tr.spans = append(tr.spans, span)
+	tr.mu.Unlock()
+
+	return context.WithValue(ctx, testSpanKey{}, span), span
+}
+
+func TestTracer_UpdateSpanParentsAPICalls(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(req.URL.Path, "/deleteMessage") {
+				return jsonHTTPResponse(t, &gogram.Response{
+					ErrorCode:   http.StatusForbidden,
+					Description: "Forbidden: bot was blocked by the user",
+					Result:      json.RawMessage(`null`),
+				}), nil
+			}
+
+			msg := gogram.Message{MessageID: 1}
+			return jsonHTTPResponse(t, &gogram.Response{OK: true, Result: mustMarshal(t, &msg)}), nil
+		}),
+	}
+
+	router := gogram.NewRouter()
+	router.HandleOnMessage(func(ctx *gogram.Context, _ *gogram.Message) error {
+		if _, err := ctx.SendMessage("pong"); err != nil {
+			return err
+		}
+		return ctx.DeleteMessage()
+	})
+
+	tracer := new(testTracer)
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost("example.invalid"),
+		gogram.WithHTTPClient(httpClient),
+		gogram.WithRouter(router),
+		gogram.WithTracer(tracer),
+		// Setting the option again does not trace calls twice.
+		gogram.WithTracer(tracer),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	deliveries := make(chan gogram.Delivery, 1)
+	deliveries <- gogram.Delivery{Update: &gogram.Update{
+		UpdateID: 9,
+		Message:  &gogram.Message{Chat: gogram.Chat{ID: 100}, From: &gogram.User{ID: 200}},
+	}}
+	close(deliveries)
+
+	if err = client.Run(t.Context(), gogram.ChannelSource(deliveries)); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if len(tracer.spans) != 3 {
+		t.Fatalf("expected 3 spans, got %d", len(tracer.spans))
+	}
+
+	update, send, del := tracer.spans[0], tracer.spans[1], tracer.spans[2]
+
+	if update.name != "update message" || update.parent != nil {
+		t.Errorf("unexpected update span %q with parent %v", update.name, update.parent)
+	}
+	for key, want := range map[string]any{
+		gogram.AttrUpdateID:   int64(9),
+		gogram.AttrChatID:     int64(100),
+		gogram.AttrUserID:     int64(200),
+		gogram.AttrUpdateKind: "message",
+		gogram.AttrErrorType:  "forbidden",
+	} {
+		if got := update.attrs[key]; got != want {
+			t.Errorf("update span %s = %v, want %v", key, got, want)
+		}
+	}
+
+	if send.name != "sendMessage" || send.parent != update || send.err != nil {
+		t.Errorf("unexpected sendMessage span %+v", send)
+	}
+	if del.name != "deleteMessage" || del.parent != update || del.attrs[gogram.AttrErrorType] != "forbidden" {
+		t.Errorf("unexpected deleteMessage span %+v", del)
+	}
+
+	for _, span := range tracer.spans {
+		if !span.ended {
+			t.Errorf("span %q was not ended", span.name)
+		}
+	}
+}
@@ -1,20 +1,87 @@
 package gogram
 
 import (
+	"bytes"
 	"encoding"
 	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
 )
 
 // InputFile represents a file upload or an existing file reference.
+//
+// Files to upload are best created with [InputFileFromPath],
+// [InputFileFromBytes] or [InputFileFromFS], which open the file for each
+// request and so can be sent several times and retried. A File set directly is
+// read once and closed after the request if it is an [io.Closer].
 type InputFile struct {
 	FileID   string
 	FileURL  string
 	File     io.Reader
 	FileName string
 
+	// ContentType is the media type of the upload. If empty, it is detected
+	// from the first bytes of the file or from the FileName extension.
+	ContentType string
+
+	// CacheKey identifies the file in the cache set with [WithFileIDCache]
+	// instead of the hash of its contents.
+	CacheKey string
+
+	open      func() (io.Reader, error)
 	fieldName string
 }
 
+// InputFileFromPath returns an InputFile uploading the file at name.
+// The file is opened when a request is sent and closed after it.
+func InputFileFromPath(name string) InputFile {
+	return InputFile{
+		FileName: filepath.Base(name),
+		open: func() (io.Reader, error) {
+			return os.Open(name)
+		},
+	}
+}
+
+// InputFileFromBytes returns an InputFile uploading data as a file named name.
+func InputFileFromBytes(name string, data []byte) InputFile {
+	return InputFile{
+		FileName: name,
+		open: func() (io.Reader, error) {
+			return bytes.NewReader(data), nil
+		},
+	}
+}
+
+// InputFileFromFS returns an InputFile uploading the file name of fsys, such
+// as an [embed.FS]. The file is opened when a request is sent and closed after it.
+func InputFileFromFS(fsys fs.FS, name string) InputFile {
+	return InputFile{
+		FileName: path.Base(name),
+		open: func() (io.Reader, error) {
+			return fsys.Open(name)
+		},
+	}
+}
+
+// InputFileFromURL returns an InputFile that Telegram downloads from url.
+func InputFileFromURL(url string) InputFile {
+	return InputFile{FileURL: url}
+}
+
+// InputFileFromFileID returns an InputFile referring to a file already stored
+// on the Telegram servers.
+func InputFileFromFileID(fileID string) InputFile {
+	return InputFile{FileID: fileID}
+}
+
+// isUpload reports whether the file is uploaded with the request.
+func (r *InputFile) isUpload() bool {
+	return r.File != nil || r.open != nil
+}
+
 var (
 	_ encoding.TextAppender  = (*InputFile)(nil)
 	_ encoding.TextMarshaler = (*InputFile)(nil)
@@ -29,7 +96,7 @@ func (r *InputFile) AppendText(buf []byte) ([]byte, error) {
 	case r.FileURL != "":
 		return append(buf, r.FileURL...), nil
 
-	case r.File != nil:
+	case r.isUpload():
 		return append(append(buf, "attach://"...), r.fieldName...), nil
 
 	default:
@@ -0,0 +1,49 @@
+package gogram
+
+import "time"
+
+// Time returns the moment the update happened, or the zero time when the
+// update kind carries no date (inline and callback queries, polls and others).
+// For edited messages it is the edit date.
+func (u *Update) Time() time.Time {
+	var date int64
+
+	switch {
+	case u.Message != nil:
+		date = u.Message.Date
+	case u.EditedMessage != nil:
+		date = max(u.EditedMessage.EditDate, u.EditedMessage.Date)
+	case u.ChannelPost != nil:
+		date = u.ChannelPost.Date
+	case u.EditedChannelPost != nil:
+		date = max(u.EditedChannelPost.EditDate, u.EditedChannelPost.Date)
+	case u.BusinessConnection != nil:
+		date = u.BusinessConnection.Date
+	case u.BusinessMessage != nil:
+		date = u.BusinessMessage.Date
+	case u.EditedBusinessMessage != nil:
+		date = max(u.EditedBusinessMessage.EditDate, u.EditedBusinessMessage.Date)
+	case u.GuestMessage != nil:
+		date = u.GuestMessage.Date
+	case u.MessageReaction != nil:
+		date = u.MessageReaction.Date
+	case u.MessageReactionCount != nil:
+		date = u.MessageReactionCount.Date
+	case u.MyChatMember != nil:
+		date = u.MyChatMember.Date
+	case u.ChatMember != nil:
+		date = u.ChatMember.Date
+	case u.ChatJoinRequest != nil:
+		date = u.ChatJoinRequest.Date
+	case u.ChatBoost != nil:
+		date = u.ChatBoost.Boost.AddDate
+	case u.RemovedChatBoost != nil:
+		date = u.RemovedChatBoost.RemoveDate
+	}
+
+	if date == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(date, 0)
+}
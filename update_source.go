@@ -0,0 +1,172 @@
+package gogram
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+)
+
+const defaultJSONLineMaxBytes = 4 << 20
+
+// Ack acknowledges an update delivered by an [UpdateSource]. It is called
+// once after processing with the handler error, or nil on success, so that
+// a queue-backed source can commit or redeliver the update.
+type Ack func(err error)
+
+// DeliverFunc passes an update received by an [UpdateSource] to the client.
+// ctx becomes the parent of the handler [Context]. It blocks while the
+// dispatcher is full and returns an error if the update was not accepted,
+// in which case ack is never called. A nil ack is allowed.
+type DeliverFunc func(ctx context.Context, update *Update, ack Ack) error
+
+// UpdateSource receives updates for [Client.Run].
+type UpdateSource interface {
+	// Receive delivers updates until ctx is done or the source is exhausted.
+	Receive(ctx context.Context, deliver DeliverFunc) error
+}
+
+// Run feeds updates from source into the router until ctx is cancelled,
+// [Client.Stop] is called or the source is exhausted, then waits for all
+// accepted updates to be processed.
+func (c *Client) Run(ctx context.Context, source UpdateSource) error {
+	innerCtx, state, err := c.beginRun(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.finishRun(state)
+
+	dispatcher := c.cfg.dispatcher
+	if dispatcher == nil {
+		numWorkers := c.cfg.numWorkers
+		if numWorkers <= 0 {
+			numWorkers = defaultUpdates
+			if polling, ok := source.(*PollingSource); ok && polling.params.Limit > 0 {
+				numWorkers = int(polling.params.Limit)
+			}
+		}
+
+		dispatcher = NewQueueDispatcher(DispatchByChat, numWorkers)
+	}
+
+	c.log(innerCtx, slog.LevelInfo, "gogram: started")
+
+	err = source.Receive(innerCtx, func(ctx context.Context, update *Update, ack Ack) error {
+		return c.deliver(ctx, dispatcher, update, ack)
+	})
+
+	c.log(innerCtx, slog.LevelInfo, "gogram: shutting down, waiting for handlers")
+	dispatcher.Wait()
+
+	if err != nil {
+		c.log(innerCtx, slog.LevelError, "gogram: stopped", slog.Any("error", err))
+	} else {
+		c.log(innerCtx, slog.LevelInfo, "gogram: stopped")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err = ctx.Err(); !errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) deliver(ctx context.Context, dispatcher Dispatcher, update *Update, ack Ack) error {
+	if ack == nil {
+		ack = func(error) {}
+	}
+
+	if !c.acceptUpdate(ctx, update) {
+		ack(nil)
+		return nil
+	}
+
+	gogramCtx := c.acquireContext(ctx, update)
+
+	err := dispatcher.Dispatch(gogramCtx, func(gogramCtx *Context) {
+		ack(c.processUpdate(gogramCtx))
+	})
+	if err != nil {
+		c.releaseContext(gogramCtx)
+	}
+
+	return err
+}
+
+// Delivery is an update with its acknowledgement, as read by [ChannelSource].
+type Delivery struct {
+	Update *Update
+	Ack    Ack
+}
+
+var _ UpdateSource = ChannelSource(nil)
+
+// ChannelSource is an [UpdateSource] reading deliveries from a channel,
+// e.g. filled by a message queue consumer. Receive returns when the channel is closed.
+type ChannelSource <-chan Delivery
+
+// Receive implements [UpdateSource].
+func (s ChannelSource) Receive(ctx context.Context, deliver DeliverFunc) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case delivery, ok := <-s:
+			if !ok {
+				return nil
+			}
+
+			if err := deliver(ctx, delivery.Update, delivery.Ack); err != nil {
+				if delivery.Ack != nil {
+					delivery.Ack(err)
+				}
+
+				return nil
+			}
+		}
+	}
+}
+
+var _ UpdateSource = (*JSONLSource)(nil)
+
+// JSONLSource is an [UpdateSource] reading one JSON-encoded [Update] per line,
+// e.g. a captured session. Receive returns at the end of the input.
+type JSONLSource struct {
+	reader io.Reader
+}
+
+// NewJSONLSource creates a JSONLSource reading from r.
+func NewJSONLSource(r io.Reader) *JSONLSource {
+	return &JSONLSource{reader: r}
+}
+
+// Receive implements [UpdateSource].
+func (s *JSONLSource) Receive(ctx context.Context, deliver DeliverFunc) error {
+	scanner := bufio.NewScanner(s.reader)
+	scanner.Buffer(nil, defaultJSONLineMaxBytes)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		update := new(Update)
+		if err := json.Unmarshal(line, update); err != nil {
+			return err
+		}
+
+		if err := deliver(ctx, update, nil); err != nil {
+			return nil
+		}
+	}
+
+	return scanner.Err()
+}
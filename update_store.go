@@ -0,0 +1,141 @@
+package gogram
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+const defaultUpdateStoreWindow = 1000
+
+// UpdateStore persists update processing progress so that a restarted or
+// redelivering bot does not handle the same update twice.
+//
+// Updates are marked as seen when they are received, before processing,
+// which gives at-most-once handling: an update interrupted by a crash is not
+// processed again.
+type UpdateStore interface {
+	// LoadOffset returns the last confirmed getUpdates offset of the bot, or zero.
+	LoadOffset(ctx context.Context, botID int64) (int64, error)
+	// SaveOffset stores the getUpdates offset of the bot.
+	SaveOffset(ctx context.Context, botID, offset int64) error
+	// MarkSeen records updateID and reports whether it had already been seen.
+	MarkSeen(ctx context.Context, botID, updateID int64) (seen bool, err error)
+}
+
+// WithUpdateStore sets the store used to resume polling from the last
+// confirmed offset and to drop duplicate updates in both polling and webhook modes.
+func WithUpdateStore(store UpdateStore) ClientOption {
+	return func(c *Client) {
+		c.cfg.updateStore = store
+	}
+}
+
+// acceptUpdate reports whether the update has not been seen yet.
+// Store failures are logged and reported to the router and the update is accepted.
+func (c *Client) acceptUpdate(ctx context.Context, update *Update) bool {
+	store := c.cfg.updateStore
+	if store == nil {
+		return true
+	}
+
+	seen, err := store.MarkSeen(ctx, c.id, update.UpdateID)
+	if err != nil {
+		c.reportErr(ctx, "gogram: marking update as seen failed", err)
+		return true
+	}
+
+	return !seen
+}
+
+// reportErr logs err at Error with msg and passes it to the router's HandleErr.
+func (c *Client) reportErr(ctx context.Context, msg string, err error) {
+	c.log(ctx, slog.LevelError, msg, slog.Any("error", err))
+	c.handleErr(ctx, err)
+}
+
+// handleErr passes err to the router's HandleErr, for errors already logged.
+func (c *Client) handleErr(ctx context.Context, err error) {
+	gogramCtx := c.acquireContext(ctx, nil)
+	c.cfg.router.HandleErr(gogramCtx, err)
+	c.releaseContext(gogramCtx)
+}
+
+var _ UpdateStore = (*MemoryUpdateStore)(nil)
+
+// MemoryUpdateStore is an in-memory [UpdateStore].
+// It survives polling restarts within the process but not process restarts.
+type MemoryUpdateStore struct {
+	mu      sync.Mutex
+	window  int
+	offsets map[int64]int64
+	seen    map[int64]*memorySeen
+}
+
+// memorySeen holds the update IDs seen by a bot, evicting the oldest first.
+type memorySeen struct {
+	ids   map[int64]struct{}
+	order []int64 // ring of ids in insertion order
+	next  int     // index of the oldest id once order is full
+}
+
+// NewMemoryUpdateStore creates a MemoryUpdateStore that remembers the last
+// window update IDs of each bot. A non-positive window selects the default of 1000.
+func NewMemoryUpdateStore(window int) *MemoryUpdateStore {
+	if window <= 0 {
+		window = defaultUpdateStoreWindow
+	}
+
+	return &MemoryUpdateStore{
+		window:  window,
+		offsets: make(map[int64]int64),
+		seen:    make(map[int64]*memorySeen),
+	}
+}
+
+// LoadOffset implements [UpdateStore].
+func (s *MemoryUpdateStore) LoadOffset(_ context.Context, botID int64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.offsets[botID], nil
+}
+
+// SaveOffset implements [UpdateStore].
+func (s *MemoryUpdateStore) SaveOffset(_ context.Context, botID, offset int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.offsets[botID] = offset
+
+	return nil
+}
+
+// MarkSeen implements [UpdateStore].
+func (s *MemoryUpdateStore) MarkSeen(_ context.Context, botID, updateID int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen := s.seen[botID]
+	if seen == nil {
+		seen = &memorySeen{ids: make(map[int64]struct{})}
+		s.seen[botID] = seen
+	}
+
+	if _, ok := seen.ids[updateID]; ok {
+		return true, nil
+	}
+
+	seen.ids[updateID] = struct{}{}
+
+	if len(seen.order) < s.window {
+		seen.order = append(seen.order, updateID)
+		return false, nil
+	}
+
+	delete(seen.ids, seen.order[seen.next])
+	seen.order[seen.next] = updateID
+	seen.next = (seen.next + 1) % s.window
+
+	return false, nil
+}
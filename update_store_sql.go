@@ -0,0 +1,217 @@
+package gogram
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// SQLStoreOption configures SQL-backed stores.
+type SQLStoreOption func(cfg *sqlStoreConfig)
+
+type sqlStoreConfig struct {
+	prefix       string
+	dollarParams bool
+	window       int64
+}
+
+// WithSQLTablePrefix replaces the default "gogram_" table name prefix.
+func WithSQLTablePrefix(prefix string) SQLStoreOption {
+	return func(cfg *sqlStoreConfig) {
+		cfg.prefix = prefix
+	}
+}
+
+// WithSQLDollarPlaceholders makes queries use $1, $2, … placeholders
+// as required by PostgreSQL drivers instead of the default "?".
+func WithSQLDollarPlaceholders() SQLStoreOption {
+	return func(cfg *sqlStoreConfig) {
+		cfg.dollarParams = true
+	}
+}
+
+// WithSQLWindow sets how many update IDs below the latest one are remembered.
+func WithSQLWindow(window int) SQLStoreOption {
+	return func(cfg *sqlStoreConfig) {
+		if window > 0 {
+			cfg.window = int64(window)
+		}
+	}
+}
+
+func newSQLStoreConfig(opts []SQLStoreOption) sqlStoreConfig {
+	cfg := sqlStoreConfig{
+		prefix: "gogram_",
+		window: defaultUpdateStoreWindow,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return cfg
+}
+
+// rebind replaces "?" placeholders with "$N" when configured.
+func (cfg *sqlStoreConfig) rebind(query string) string {
+	if !cfg.dollarParams {
+		return query
+	}
+
+	var b strings.Builder
+
+	n := 0
+
+	for i := range len(query) {
+		if query[i] != '?' {
+			b.WriteByte(query[i])
+			continue
+		}
+
+		n++
+		b.WriteByte('$')
+		b.WriteString(strconv.Itoa(n))
+	}
+
+	return b.String()
+}
+
+var _ UpdateStore = (*SQLUpdateStore)(nil)
+
+// SQLUpdateStore is a [database/sql] backed [UpdateStore].
+//
+// Queries use portable SQL only, so it works with SQLite, MySQL and
+// PostgreSQL (see [WithSQLDollarPlaceholders]). Call [SQLUpdateStore.Init]
+// once to create the tables.
+type SQLUpdateStore struct {
+	db  *sql.DB
+	cfg sqlStoreConfig
+
+	queryLoadOffset   string
+	queryUpdateOffset string
+	queryInsertOffset string
+	querySelectSeen   string
+	queryInsertSeen   string
+	queryPruneSeen    string
+
+	inserted atomic.Int64
+}
+
+// NewSQLUpdateStore creates an SQLUpdateStore on top of db.
+func NewSQLUpdateStore(db *sql.DB, opts ...SQLStoreOption) *SQLUpdateStore {
+	cfg := newSQLStoreConfig(opts)
+
+	offsets := cfg.prefix + "offsets"
+	updates := cfg.prefix + "updates"
+
+	return &SQLUpdateStore{
+		db:  db,
+		cfg: cfg,
+
+		queryLoadOffset:   cfg.rebind("SELECT update_offset FROM " + offsets + " WHERE bot_id = ?"),
+		queryUpdateOffset: cfg.rebind("UPDATE " + offsets + " SET update_offset = ? WHERE bot_id = ?"),
+		queryInsertOffset: cfg.rebind("INSERT INTO " + offsets + " (bot_id, update_offset) VALUES (?, ?)"),
+		querySelectSeen:   cfg.rebind("SELECT 1 FROM " + updates + " WHERE bot_id = ? AND update_id = ?"),
+		queryInsertSeen:   cfg.rebind("INSERT INTO " + updates + " (bot_id, update_id) VALUES (?, ?)"),
+		queryPruneSeen: cfg.rebind("DELETE FROM " + updates +
+			" WHERE bot_id = ? AND (update_id <= ? OR update_id > ?)"),
+	}
+}
+
+// Init creates the store tables if they do not exist.
+func (s *SQLUpdateStore) Init(ctx context.Context) error {
+	queries := [...]string{
+		"CREATE TABLE IF NOT EXISTS " + s.cfg.prefix + "offsets (" +
+			"bot_id BIGINT NOT NULL PRIMARY KEY, " +
+			"update_offset BIGINT NOT NULL)",
+		"CREATE TABLE IF NOT EXISTS " + s.cfg.prefix + "updates (" +
+			"bot_id BIGINT NOT NULL, " +
+			"update_id BIGINT NOT NULL, " +
+			"PRIMARY KEY (bot_id, update_id))",
+	}
+
+	for _, query := range queries {
+		if _, err := s.db.ExecContext(ctx, query); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadOffset implements [UpdateStore].
+func (s *SQLUpdateStore) LoadOffset(ctx context.Context, botID int64) (int64, error) {
+	var offset int64
+
+	err := s.db.QueryRowContext(ctx, s.queryLoadOffset, botID).Scan(&offset)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+
+	return offset, err
+}
+
+// SaveOffset implements [UpdateStore].
+func (s *SQLUpdateStore) SaveOffset(ctx context.Context, botID, offset int64) error {
+	res, err := s.db.ExecContext(ctx, s.queryUpdateOffset, offset, botID)
+	if err != nil {
+		return err
+	}
+
+	if n, _ := res.RowsAffected(); n != 0 {
+		return nil
+	}
+
+	_, err = s.db.ExecContext(ctx, s.queryInsertOffset, botID, offset)
+	if err == nil {
+		return nil
+	}
+
+	// MySQL reports zero affected rows for an unchanged value,
+	// so the row may already exist.
+	if stored, loadErr := s.LoadOffset(ctx, botID); loadErr == nil && stored == offset {
+		return nil
+	}
+
+	return err
+}
+
+// MarkSeen implements [UpdateStore].
+func (s *SQLUpdateStore) MarkSeen(ctx context.Context, botID, updateID int64) (bool, error) {
+	seen, err := s.isSeen(ctx, botID, updateID)
+	if err != nil || seen {
+		return seen, err
+	}
+
+	_, err = s.db.ExecContext(ctx, s.queryInsertSeen, botID, updateID)
+	if err != nil {
+		// A concurrent delivery may have inserted the same update.
+		if seen, seenErr := s.isSeen(ctx, botID, updateID); seenErr == nil && seen {
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	if s.inserted.Add(1)%s.cfg.window == 0 {
+		// Keep a window around the current ID, which also drops the old
+		// sequence after Telegram restarts update IDs at a random value.
+		_, err = s.db.ExecContext(ctx, s.queryPruneSeen, botID, updateID-s.cfg.window, updateID+s.cfg.window)
+	}
+
+	return false, err
+}
+
+func (s *SQLUpdateStore) isSeen(ctx context.Context, botID, updateID int64) (bool, error) {
+	var one int
+
+	err := s.db.QueryRowContext(ctx, s.querySelectSeen, botID, updateID).Scan(&one)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
@@ -0,0 +1,342 @@
+package gogram_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/darxnet/gogram"
+)
+
+var errFakeSQLDuplicate = errors.New("duplicate key")
+
+// fakeSQL is a database/sql connector passing every statement to handle.
+type fakeSQL struct {
+	mu      sync.Mutex
+	queries []string
+	handle  func(query string, args []driver.Value) (fakeSQLResult, error)
+}
+
+// fakeSQLResult holds the single column rows returned by a query or the rows
+// affected by a statement.
+type fakeSQLResult struct {
+	rows     []driver.Value
+	affected int64
+}
+
+func newFakeSQL(t *testing.T, handle func(query string, args []driver.Value) (fakeSQLResult, error)) (*fakeSQL, *sql.DB) {
+	t.Helper()
+
+	f := &fakeSQL{handle: handle}
+
+	db := sql.OpenDB(f)
+	t.Cleanup(func() { _ = db.Close() })
+
+	return f, db
+}
+
+func (f *fakeSQL) run(query string, named []driver.NamedValue) (fakeSQLResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.queries = append(f.queries, query)
+
+	args := make([]driver.Value, len(named))
+	for i, arg := range named {
+		args[i] = arg.Value
+	}
+
+	return f.handle(query, args)
+}
+
+func (f *fakeSQL) Queries() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.queries)
+}
+
+func (f *fakeSQL) Connect(context.Context) (driver.Conn, error) { return fakeSQLConn{f}, nil }
+
+func (f *fakeSQL) Driver() driver.Driver { return fakeSQLDriver{} }
+
+type fakeSQLDriver struct{}
+
+func (fakeSQLDriver) Open(string) (driver.Conn, error) { return nil, driver.ErrSkip }
+
+type fakeSQLConn struct{ f *fakeSQL }
+
+func (fakeSQLConn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+
+func (fakeSQLConn) Close() error { return nil }
+
+func (fakeSQLConn) Begin() (driver.Tx, error) { return nil, driver.ErrSkip }
+
+func (c fakeSQLConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	res, err := c.f.run(query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return driver.RowsAffected(res.affected), nil
+}
+
+func (c fakeSQLConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	res, err := c.f.run(query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fakeSQLRows{rows: res.rows}, nil
+}
+
+type fakeSQLRows struct{ rows []driver.Value }
+
+func (*fakeSQLRows) Columns() []string { return []string{"value"} }
+
+func (*fakeSQLRows) Close() error { return nil }
+
+func (r *fakeSQLRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+
+	dest[0], r.rows = r.rows[0], r.rows[1:]
+
+	return nil
+}
+
+// fakeUpdateDB emulates the tables of SQLUpdateStore.
+type fakeUpdateDB struct {
+	offsets map[int64]int64
+	seen    map[[2]int64]bool
+	mysql   bool // UPDATE reports zero affected rows for an unchanged value
+}
+
+func newFakeUpdateDB() *fakeUpdateDB {
+	return &fakeUpdateDB{offsets: make(map[int64]int64), seen: make(map[[2]int64]bool)}
+}
+
+func (db *fakeUpdateDB) handle(query string, args []driver.Value) (fakeSQLResult, error) {
+	switch {
+	case strings.HasPrefix(query, "SELECT update_offset FROM gogram_offsets"):
+		if offset, ok := db.offsets[args[0].(int64)]; ok {
+			return fakeSQLResult{rows: []driver.Value{offset}}, nil
+		}
+
+		return fakeSQLResult{}, nil
+
+	case strings.HasPrefix(query, "UPDATE gogram_offsets"):
+		offset, botID := args[0].(int64), args[1].(int64)
+
+		stored, ok := db.offsets[botID]
+		if !ok || db.mysql && stored == offset {
+			return fakeSQLResult{}, nil
+		}
+
+		db.offsets[botID] = offset
+
+		return fakeSQLResult{affected: 1}, nil
+
+	case strings.HasPrefix(query, "INSERT INTO gogram_offsets"):
+		botID, offset := args[0].(int64), args[1].(int64)
+		if _, ok := db.offsets[botID]; ok {
+			return fakeSQLResult{}, errFakeSQLDuplicate
+		}
+
+		db.offsets[botID] = offset
+
+		return fakeSQLResult{affected: 1}, nil
+
+	case strings.HasPrefix(query, "SELECT 1 FROM gogram_updates"):
+		if db.seen[[2]int64{args[0].(int64), args[1].(int64)}] {
+			return fakeSQLResult{rows: []driver.Value{int64(1)}}, nil
+		}
+
+		return fakeSQLResult{}, nil
+
+	case strings.HasPrefix(query, "INSERT INTO gogram_updates"):
+		key := [2]int64{args[0].(int64), args[1].(int64)}
+		if db.seen[key] {
+			return fakeSQLResult{}, errFakeSQLDuplicate
+		}
+
+		db.seen[key] = true
+
+		return fakeSQLResult{affected: 1}, nil
+
+	case strings.HasPrefix(query, "DELETE FROM gogram_updates"):
+		botID, low, high := args[0].(int64), args[1].(int64), args[2].(int64)
+
+		var res fakeSQLResult
+		for key := range db.seen {
+			if key[0] == botID && (key[1] <= low || key[1] > high) {
+				delete(db.seen, key)
+				res.affected++
+			}
+		}
+
+		return res, nil
+	}
+
+	return fakeSQLResult{}, errors.New("unexpected query: " + query)
+}
+
+func TestSQLUpdateStore_Placeholders(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name string
+		opts []gogram.SQLStoreOption
+		want []string
+	}{
+		{
+			name: "question marks",
+			want: []string{
+				"UPDATE gogram_offsets SET update_offset = ? WHERE bot_id = ?",
+				"INSERT INTO gogram_offsets (bot_id, update_offset) VALUES (?, ?)",
+				"SELECT update_offset FROM gogram_offsets WHERE bot_id = ?",
+				"SELECT 1 FROM gogram_updates WHERE bot_id = ? AND update_id = ?",
+				"INSERT INTO gogram_updates (bot_id, update_id) VALUES (?, ?)",
+				"DELETE FROM gogram_updates WHERE bot_id = ? AND (update_id <= ? OR update_id > ?)",
+			},
+		},
+		{
+			name: "dollar placeholders",
+			opts: []gogram.SQLStoreOption{gogram.WithSQLDollarPlaceholders(), gogram.WithSQLTablePrefix("bot_")},
+			want: []string{
+				"UPDATE bot_offsets SET update_offset = $1 WHERE bot_id = $2",
+				"INSERT INTO bot_offsets (bot_id, update_offset) VALUES ($1, $2)",
+				"SELECT update_offset FROM bot_offsets WHERE bot_id = $1",
+				"SELECT 1 FROM bot_updates WHERE bot_id = $1 AND update_id = $2",
+				"INSERT INTO bot_updates (bot_id, update_id) VALUES ($1, $2)",
+				"DELETE FROM bot_updates WHERE bot_id = $1 AND (update_id <= $2 OR update_id > $3)",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f, db := newFakeSQL(t, func(string, []driver.Value) (fakeSQLResult, error) {
+				return fakeSQLResult{}, nil
+			})
+
+			opts := append(slices.Clone(tt.opts), gogram.WithSQLWindow(1))
+			store := gogram.NewSQLUpdateStore(db, opts...)
+
+			if err := store.SaveOffset(t.Context(), 1, 10); err != nil {
+				t.Fatalf("SaveOffset: %v", err)
+			}
+
+			if _, err := store.LoadOffset(t.Context(), 1); err != nil {
+				t.Fatalf("LoadOffset: %v", err)
+			}
+
+			if _, err := store.MarkSeen(t.Context(), 1, 10); err != nil {
+				t.Fatalf("MarkSeen: %v", err)
+			}
+
+			if queries := f.Queries(); !slices.Equal(queries, tt.want) {
+				t.Errorf("queries = %q, want %q", queries, tt.want)
+			}
+		})
+	}
+}
+
+func TestSQLUpdateStore_Offset(t *testing.T) {
+	t.Parallel()
+
+	for _, mysql := range []bool{false, true} {
+		fakeDB := newFakeUpdateDB()
+		fakeDB.mysql = mysql
+
+		_, db := newFakeSQL(t, fakeDB.handle)
+		store := gogram.NewSQLUpdateStore(db)
+
+		if offset, err := store.LoadOffset(t.Context(), 1); err != nil || offset != 0 {
+			t.Fatalf("LoadOffset without a row = %d, %v; want 0, nil", offset, err)
+		}
+
+		for _, offset := range []int64{10, 10, 12} {
+			if err := store.SaveOffset(t.Context(), 1, offset); err != nil {
+				t.Fatalf("SaveOffset(%d) with mysql=%t: %v", offset, mysql, err)
+			}
+
+			if got, err := store.LoadOffset(t.Context(), 1); err != nil || got != offset {
+				t.Fatalf("LoadOffset = %d, %v; want %d", got, err, offset)
+			}
+		}
+
+		if offset, err := store.LoadOffset(t.Context(), 2); err != nil || offset != 0 {
+			t.Errorf("LoadOffset of another bot = %d, %v; want 0, nil", offset, err)
+		}
+	}
+}
+
+func TestSQLUpdateStore_SaveOffsetError(t *testing.T) {
+	t.Parallel()
+
+	fakeDB := newFakeUpdateDB()
+	fakeDB.mysql = true
+	fakeDB.offsets[1] = 10
+
+	_, db := newFakeSQL(t, func(query string, args []driver.Value) (fakeSQLResult, error) {
+		if strings.HasPrefix(query, "UPDATE") {
+			// No affected rows although the stored offset differs.
+			return fakeSQLResult{}, nil
+		}
+
+		return fakeDB.handle(query, args)
+	})
+
+	err := gogram.NewSQLUpdateStore(db).SaveOffset(t.Context(), 1, 12)
+	if !errors.Is(err, errFakeSQLDuplicate) {
+		t.Fatalf("SaveOffset = %v, want the insert error", err)
+	}
+}
+
+func TestSQLUpdateStore_MarkSeen(t *testing.T) {
+	t.Parallel()
+
+	fakeDB := newFakeUpdateDB()
+
+	_, db := newFakeSQL(t, fakeDB.handle)
+	store := gogram.NewSQLUpdateStore(db, gogram.WithSQLWindow(2))
+
+	markSeen := func(updateID int64, want bool) {
+		t.Helper()
+
+		seen, err := store.MarkSeen(t.Context(), 1, updateID)
+		if err != nil {
+			t.Fatalf("MarkSeen(%d): %v", updateID, err)
+		}
+
+		if seen != want {
+			t.Errorf("MarkSeen(%d) = %t, want %t", updateID, seen, want)
+		}
+	}
+
+	markSeen(1, false)
+	markSeen(1, true)
+	markSeen(2, false) // every second insert prunes, keeping (0, 4]
+	markSeen(2, true)
+
+	if seen, err := store.MarkSeen(t.Context(), 2, 1); err != nil || seen {
+		t.Errorf("MarkSeen of another bot = %t, %v; want false, nil", seen, err)
+	}
+
+	markSeen(3, false) // keeps (1, 5]
+	markSeen(1, false)
+	markSeen(2, true)
+	markSeen(3, true)
+
+	if seen, err := store.MarkSeen(t.Context(), 2, 1); err != nil || !seen {
+		t.Errorf("MarkSeen of another bot = %t, %v; want true, nil", seen, err)
+	}
+}
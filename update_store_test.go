@@ -0,0 +1,41 @@
+package gogram_test
+
+import (
+	"testing"
+
+	"github.com/darxnet/gogram"
+)
+
+func TestMemoryUpdateStore_MarkSeen(t *testing.T) {
+	t.Parallel()
+
+	store := gogram.NewMemoryUpdateStore(3)
+
+	markSeen := func(botID, updateID int64, want bool) {
+		t.Helper()
+
+		seen, err := store.MarkSeen(t.Context(), botID, updateID)
+		if err != nil {
+			t.Fatalf("MarkSeen(%d, %d): %v", botID, updateID, err)
+		}
+
+		if seen != want {
+			t.Errorf("MarkSeen(%d, %d) = %t, want %t", botID, updateID, seen, want)
+		}
+	}
+
+	markSeen(1, 100, false)
+	markSeen(1, 101, false)
+	markSeen(1, 102, false)
+	markSeen(1, 101, true)
+
+	// An old ID only evicts the oldest one, the recent IDs are still seen.
+	markSeen(1, 5, false)
+	markSeen(1, 101, true)
+	markSeen(1, 102, true)
+	markSeen(1, 5, true)
+	markSeen(1, 100, false)
+
+	markSeen(2, 101, false)
+	markSeen(1, 102, true)
+}
@@ -0,0 +1,105 @@
+package gogram
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// chatActionInterval is how often KeepChatAction repeats the action; Telegram
+// shows it for 5 seconds or until a message is sent.
+const chatActionInterval = 4 * time.Second
+
+// UploadProgress reports the bytes of a multipart request body sent so far.
+type UploadProgress struct {
+	// Method is the Bot API method being called.
+	Method string
+	// Sent is the number of body bytes sent in the current attempt; it starts
+	// again from zero when the call is retried.
+	Sent int64
+	// Total is the length of the body, or -1 if unknown.
+	Total int64
+}
+
+// Done reports whether the whole body was sent.
+func (p UploadProgress) Done() bool {
+	return p.Total >= 0 && p.Sent >= p.Total
+}
+
+// ContextWithUploadProgress returns a copy of ctx whose API calls with files
+// report the upload progress to fn at most every interval, and once more when
+// the whole body is sent. fn is called from the goroutine sending the request
+// and should return quickly. Cancelling ctx aborts an upload in progress.
+func ContextWithUploadProgress(ctx context.Context, interval time.Duration, fn func(UploadProgress)) context.Context {
+	return withCallOptions(ctx, func(opts *callOptions) {
+		opts.progress = fn
+		opts.progressInterval = interval
+	})
+}
+
+// KeepChatAction sends the chat action in params right away and then every
+// few seconds until stop is called or ctx is done, so that the action is
+// shown during long operations such as uploads:
+//
+//	stop := client.KeepChatAction(ctx, &gogram.SendChatActionParams{
+//		ChatID: chatID,
+//		Action: gogram.ChatActionUploadVideo,
+//	})
+//	defer stop()
+//
+// The actions are sent with [PriorityLow]; failures are logged and do not
+// stop the loop. stop waits for the action being sent, if any.
+func (c *Client) KeepChatAction(ctx context.Context, params *SendChatActionParams) (stop func()) {
+	ctx, cancel := context.WithCancel(ContextWithCallPriority(ctx, PriorityLow))
+
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		ticker := time.NewTicker(chatActionInterval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := c.SendChatAction(ctx, params); err != nil && ctx.Err() == nil {
+				c.log(ctx, slog.LevelWarn, "gogram: sending chat action failed",
+					slog.String("action", params.Action),
+					slog.String("chat_id", params.ChatID),
+					slog.Any("error", err),
+				)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+
+			case <-ticker.C:
+			}
+		}
+	})
+
+	return func() {
+		cancel()
+		wg.Wait()
+	}
+}
+
+// KeepChatAction calls Client.KeepChatAction for the chat of the update, like
+// [Context.SendChatAction] does for a single action. The returned stop should
+// be called before the handler returns.
+func (ctx *Context) KeepChatAction(action string, opts ...SendChatActionOption) (stop func()) {
+	ctx.checkReleased()
+
+	params := &SendChatActionParams{Action: action}
+
+	if c := ctx.Chat(); c != nil {
+		params.ChatID = c.Identifier()
+	}
+
+	if m := ctx.Message(); m != nil {
+		params.BusinessConnectionID = m.BusinessConnectionID
+		params.MessageThreadID = m.MessageThreadID
+	}
+
+	params.Option(opts...)
+
+	return ctx.client.KeepChatAction(ctx.context, params)
+}
@@ -0,0 +1,136 @@
+package gogram_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/darxnet/gogram"
+)
+
+func TestClient_SendDocument_UploadProgress(t *testing.T) {
+	t.Parallel()
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost("example.invalid"),
+		gogram.WithHTTPClient(&http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				if _, err := io.Copy(io.Discard, req.Body); err != nil {
+					return nil, err
+				}
+
+				return jsonHTTPResponse(t, &gogram.Response{OK: true, Result: json.RawMessage(`{"message_id":1}`)}), nil
+			}),
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	document := bytes.Repeat([]byte("0123456789abcdef"), 1<<17) // 2 MiB
+
+	t.Run("Progress", func(t *testing.T) {
+		t.Parallel()
+
+		var events []gogram.UploadProgress
+		ctx := gogram.ContextWithUploadProgress(t.Context(), 0, func(p gogram.UploadProgress) {
+			events = append(events, p)
+		})
+
+		_, err := client.SendDocument(ctx, &gogram.SendDocumentParams{
+			ChatID:   "1",
+			Document: gogram.InputFile{File: bytes.NewReader(document), FileName: "doc.bin"},
+		})
+		if err != nil {
+			t.Fatalf("SendDocument: %v", err)
+		}
+
+		if len(events) < 2 {
+			t.Fatalf("got %d progress events, want several", len(events))
+		}
+
+		for i, p := range events {
+			if p.Method != "sendDocument" || p.Total <= int64(len(document)) {
+				t.Errorf("event %d = %+v", i, p)
+			}
+
+			if i > 0 && p.Sent < events[i-1].Sent {
+				t.Errorf("event %d: Sent went back from %d to %d", i, events[i-1].Sent, p.Sent)
+			}
+		}
+
+		if last := events[len(events)-1]; !last.Done() {
+			t.Errorf("last event %+v is not done", last)
+		}
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+
+		ctx = gogram.ContextWithUploadProgress(ctx, 0, func(gogram.UploadProgress) { cancel() })
+
+		_, err := client.SendDocument(ctx, &gogram.SendDocumentParams{
+			ChatID:   "1",
+			Document: gogram.InputFile{File: bytes.NewReader(document), FileName: "doc.bin"},
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	})
+}
+
+func TestClient_KeepChatAction(t *testing.T) {
+	t.Parallel()
+
+	var sent atomic.Int32
+	first := make(chan struct{}, 1)
+
+	client, err := gogram.NewClient(testToken,
+		gogram.WithHost("example.invalid"),
+		gogram.WithHTTPClient(&http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				body, _ := io.ReadAll(req.Body)
+
+				if !strings.HasSuffix(req.URL.Path, "/sendChatAction") || !bytes.Contains(body, []byte(`"upload_video"`)) {
+					t.Errorf("unexpected request %s %s", req.URL.Path, body)
+				}
+
+				sent.Add(1)
+
+				select {
+				case first <- struct{}{}:
+				default:
+				}
+
+				return jsonHTTPResponse(t, &gogram.Response{OK: true, Result: json.RawMessage(`true`)}), nil
+			}),
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	stop := client.KeepChatAction(t.Context(), &gogram.SendChatActionParams{
+		ChatID: "1",
+		Action: gogram.ChatActionUploadVideo,
+	})
+
+	<-first
+	stop()
+
+	got := sent.Load()
+	stop()
+
+	if sent.Load() != got {
+		t.Errorf("chat action sent after stop")
+	}
+}